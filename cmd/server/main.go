@@ -14,8 +14,11 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/recurrence-scheduler/internal/caldav"
 	"github.com/recurrence-scheduler/internal/server"
 	"github.com/recurrence-scheduler/internal/storage"
+	"github.com/recurrence-scheduler/internal/trigger"
+	"github.com/recurrence-scheduler/internal/webhook"
 	pb "github.com/recurrence-scheduler/proto/scheduler/v1"
 )
 
@@ -23,6 +26,8 @@ var (
 	grpcPort = flag.String("grpc-port", "50051", "gRPC server port")
 	httpPort = flag.String("http-port", "8080", "HTTP server port")
 	dbPath   = flag.String("db", "./data/scheduler.db", "Database file path")
+	smtpAddr = flag.String("smtp-addr", "", "SMTP relay address (host:port) for email reminders; empty disables email")
+	smtpFrom = flag.String("smtp-from", "scheduler@localhost", "From address of email reminders")
 )
 
 func main() {
@@ -42,7 +47,8 @@ func main() {
 
 	// gRPCサーバーを作成
 	grpcServer := grpc.NewServer()
-	srv := server.NewServer(st)
+	occurrences := trigger.NewBroadcaster()
+	srv := server.NewServer(st).WithOccurrences(occurrences)
 	pb.RegisterSchedulerServiceServer(grpcServer, srv)
 
 	// gRPCリスナーを作成
@@ -64,6 +70,15 @@ func main() {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	// Webhookの配信をバックグラウンドで送信する
+	go webhook.NewDispatcher(st).Run(ctx)
+	// 繰り返しイベントのインスタンスの開始時刻とリマインダーの時刻にアクションを実行する
+	actions := []trigger.Action{trigger.LogAction{}, trigger.NewStdoutNotifier(os.Stdout), trigger.NewWebhookAction(st), occurrences}
+	if *smtpAddr != "" {
+		actions = append(actions, trigger.NewSMTPNotifier(*smtpAddr, *smtpFrom))
+	}
+	go trigger.NewEngine(st, actions...).Run(ctx)
+
 	mux := runtime.NewServeMux()
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 	
@@ -75,7 +90,7 @@ func main() {
 	// CORS対応のラッパー
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
 		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusOK)
@@ -83,12 +98,40 @@ func main() {
 		}
 		mux.ServeHTTP(w, r)
 	})
+	// Accept: application/calendar+json（+xml）の場合はgRPC-Gatewayの代わりにjCal（xCal）で応答する
+	negotiated := srv.Negotiate(handler)
+
+	// CalDAV（RFC 4791）。クライアントの自動検出のため/.well-known/caldavからリダイレクトする（RFC 6764）
+	http.Handle("/dav/", caldav.NewHandler(st, "/dav"))
+	http.HandleFunc("/.well-known/caldav", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/dav/", http.StatusMovedPermanently)
+	})
 
 	// 静的ファイル（Viteビルド後のdistディレクトリ）
 	fs := http.FileServer(http.Dir("./web"))
 	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		// iCalendarエクスポートはgRPC-Gatewayを経由せずに直接返す
+		if strings.HasPrefix(r.URL.Path, "/api/v1/calendars/") && strings.HasSuffix(r.URL.Path, ".ics") {
+			srv.ServeICS(w, r)
+			return
+		}
+		// 購読フィードはトークンで認可する読み取り専用のiCalendar
+		if strings.HasPrefix(r.URL.Path, "/feeds/") && strings.HasSuffix(r.URL.Path, ".ics") {
+			srv.ServeFeed(w, r)
+			return
+		}
+		// 変更の通知はServer-Sent Eventsで送り続ける
+		if strings.HasPrefix(r.URL.Path, "/api/v1/calendars/") && strings.HasSuffix(r.URL.Path, "/watch") {
+			srv.ServeWatch(w, r)
+			return
+		}
+		// iCalendarの取り込みはmultipart/form-dataのアップロードも受け付ける
+		if strings.HasPrefix(r.URL.Path, "/api/v1/calendars/") && strings.HasSuffix(r.URL.Path, "/import") {
+			srv.ServeImport(w, r)
+			return
+		}
 		if strings.HasPrefix(r.URL.Path, "/api/") {
-			handler.ServeHTTP(w, r)
+			negotiated.ServeHTTP(w, r)
 			return
 		}
 		// Viteビルド後のファイルを配信
@@ -0,0 +1,350 @@
+// Package caldav はカレンダーをCalDAV（RFC 4791）で公開する
+//
+// URLの構成:
+//
+//	{prefix}/                      ルート
+//	{prefix}/principal/            プリンシパル（利用者は1人として扱う）
+//	{prefix}/calendars/            カレンダーホーム
+//	{prefix}/calendars/{id}/       カレンダーコレクション（models.Calendar）
+//	{prefix}/calendars/{id}/{name} カレンダーオブジェクトリソース（1つのUIDのVEVENT群）
+package caldav
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/recurrence-scheduler/internal/ical"
+	"github.com/recurrence-scheduler/internal/models"
+	"github.com/recurrence-scheduler/internal/storage"
+)
+
+// maxBodySize はPUTやREPORTで受け付ける本文の上限
+const maxBodySize = 10 << 20
+
+// objectContentType はカレンダーオブジェクトリソースのContent-Type
+const objectContentType = "text/calendar; charset=utf-8; component=vevent"
+
+// Handler はCalDAVのリクエストを処理する
+type Handler struct {
+	storage storage.Storage
+	prefix  string
+}
+
+// NewHandler は新しいHandlerを作成する
+// prefixはハンドラを配置するパス（例: "/dav"）
+func NewHandler(s storage.Storage, prefix string) *Handler {
+	return &Handler{storage: s, prefix: strings.TrimSuffix(prefix, "/")}
+}
+
+// resourceKind はURLが指すリソースの種類
+type resourceKind int
+
+const (
+	kindRoot resourceKind = iota
+	kindPrincipal
+	kindHome
+	kindCalendar
+	kindObject
+)
+
+// target はURLを解析した結果
+type target struct {
+	kind       resourceKind
+	calendarID string
+	name       string
+}
+
+// parsePath はリクエストのパスを解析する
+// UIDに"/"が含まれる場合があるため、エスケープされたままのパスを分割してから各部分を復元する
+func (h *Handler) parsePath(escapedPath string) (*target, bool) {
+	rest, ok := strings.CutPrefix(escapedPath, h.prefix)
+	if !ok {
+		return nil, false
+	}
+	rest = strings.Trim(rest, "/")
+	if rest == "" {
+		return &target{kind: kindRoot}, true
+	}
+
+	var segments []string
+	for _, s := range strings.Split(rest, "/") {
+		unescaped, err := url.PathUnescape(s)
+		if err != nil || unescaped == "" {
+			return nil, false
+		}
+		segments = append(segments, unescaped)
+	}
+
+	switch {
+	case len(segments) == 1 && segments[0] == "principal":
+		return &target{kind: kindPrincipal}, true
+	case len(segments) == 1 && segments[0] == "calendars":
+		return &target{kind: kindHome}, true
+	case len(segments) == 2 && segments[0] == "calendars":
+		return &target{kind: kindCalendar, calendarID: segments[1]}, true
+	case len(segments) == 3 && segments[0] == "calendars":
+		return &target{kind: kindObject, calendarID: segments[1], name: segments[2]}, true
+	}
+	return nil, false
+}
+
+func (h *Handler) principalHref() string { return h.prefix + "/principal/" }
+func (h *Handler) homeHref() string      { return h.prefix + "/calendars/" }
+
+func (h *Handler) calendarHref(calendarID string) string {
+	return h.prefix + "/calendars/" + url.PathEscape(calendarID) + "/"
+}
+
+func (h *Handler) objectHref(calendarID, name string) string {
+	return h.calendarHref(calendarID) + url.PathEscape(name)
+}
+
+// ServeHTTP はメソッドごとに処理を振り分ける
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	t, ok := h.parsePath(r.URL.EscapedPath())
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	var err error
+	switch r.Method {
+	case http.MethodOptions:
+		w.Header().Set("DAV", "1, 3, calendar-access")
+		w.Header().Set("Allow", "OPTIONS, GET, HEAD, PUT, DELETE, PROPFIND, REPORT")
+		w.WriteHeader(http.StatusOK)
+	case "PROPFIND":
+		err = h.propfind(w, r, t)
+	case "REPORT":
+		err = h.report(w, r, t)
+	case http.MethodGet, http.MethodHead:
+		err = h.get(w, r, t)
+	case http.MethodPut:
+		err = h.put(w, r, t)
+	case http.MethodDelete:
+		err = h.delete(w, r, t)
+	default:
+		w.Header().Set("Allow", "OPTIONS, GET, HEAD, PUT, DELETE, PROPFIND, REPORT")
+		err = errorf(http.StatusMethodNotAllowed, "method not allowed")
+	}
+
+	if err != nil {
+		var de *davError
+		if !errors.As(err, &de) {
+			de = errorf(http.StatusInternalServerError, "%s", err.Error())
+		}
+		de.write(w)
+	}
+}
+
+// object はカレンダーオブジェクトリソース（繰り返しイベントとそのオーバーライド）
+type object struct {
+	master    *models.Event
+	overrides []*models.Event
+	data      []byte
+	etag      string
+}
+
+// newObject はイベントからリソースの内容とETagを求める
+// ETagは保存されているイベントの内容（UpdatedAtを含む）のハッシュとする
+// 出力するiCalendarデータにはVTIMEZONEのように現在時刻で変わる部分があるため、データからは求めない
+func newObject(master *models.Event, overrides []*models.Event) *object {
+	events := append([]*models.Event{master}, overrides...)
+	data := ical.Marshal(ical.ObjectComponent(events))
+	h := sha256.New()
+	// models.Eventはすべてエンコードできるため、エラーにはならない
+	json.NewEncoder(h).Encode(events)
+	sum := h.Sum(nil)
+
+	return &object{
+		master:    master,
+		overrides: overrides,
+		data:      data,
+		etag:      `"` + hex.EncodeToString(sum[:16]) + `"`,
+	}
+}
+
+// name はリソース名を返す
+// CalDAVクライアントが指定した名前がなければUIDから求める
+func (o *object) name() string {
+	return resourceName(o.master)
+}
+
+func resourceName(e *models.Event) string {
+	if e.ResourceName != "" {
+		return e.ResourceName
+	}
+	return ical.UID(e) + ".ics"
+}
+
+// lastModified はリソース内のイベントの最新の更新日時を返す
+func (o *object) lastModified() time.Time {
+	latest := o.master.UpdatedAt
+	for _, override := range o.overrides {
+		if override.UpdatedAt.After(latest) {
+			latest = override.UpdatedAt
+		}
+	}
+	return latest
+}
+
+// calendar はカレンダーを取得する
+func (h *Handler) calendar(calendarID string) (*models.Calendar, error) {
+	cal, err := h.storage.GetCalendar(calendarID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errorf(http.StatusNotFound, "calendar not found")
+	}
+	return cal, err
+}
+
+// findObject はリソース名に対応するリソースを取得する
+func (h *Handler) findObject(calendarID, name string) (*object, error) {
+	master, err := h.storage.GetEventByResourceName(calendarID, name)
+	if errors.Is(err, sql.ErrNoRows) && strings.HasSuffix(name, ".ics") {
+		// リソース名を持たないイベントはUIDで参照する
+		master, err = h.storage.GetEventByUID(calendarID, strings.TrimSuffix(name, ".ics"))
+		if err == nil && master.ResourceName != "" {
+			err = sql.ErrNoRows
+		}
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errorf(http.StatusNotFound, "resource not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return h.loadObject(master)
+}
+
+// loadObject は繰り返しイベントのオーバーライドを読み込んでリソースを作成する
+func (h *Handler) loadObject(master *models.Event) (*object, error) {
+	overrides, err := h.storage.ListOverrides(master.ID)
+	if err != nil {
+		return nil, err
+	}
+	return newObject(master, overrides), nil
+}
+
+// listObjects はカレンダー内のすべてのリソースを返す
+func (h *Handler) listObjects(calendarID string) ([]*object, error) {
+	events, err := h.storage.ListCalendarEvents(calendarID)
+	if err != nil {
+		return nil, err
+	}
+
+	overrides := make(map[string][]*models.Event)
+	for _, e := range events {
+		if e.IsOverride() {
+			overrides[e.RecurringEventID] = append(overrides[e.RecurringEventID], e)
+		}
+	}
+
+	var objects []*object
+	for _, e := range events {
+		if !e.IsOverride() {
+			objects = append(objects, newObject(e, overrides[e.ID]))
+		}
+	}
+	return objects, nil
+}
+
+// get はカレンダーオブジェクトリソースを返す
+func (h *Handler) get(w http.ResponseWriter, r *http.Request, t *target) error {
+	if t.kind != kindObject {
+		return errorf(http.StatusMethodNotAllowed, "only calendar object resources can be retrieved")
+	}
+
+	obj, err := h.findObject(t.calendarID, t.name)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", objectContentType)
+	w.Header().Set("ETag", obj.etag)
+	w.Header().Set("Last-Modified", obj.lastModified().UTC().Format(http.TimeFormat))
+	if r.Method == http.MethodHead {
+		return nil
+	}
+	_, err = w.Write(obj.data)
+	return err
+}
+
+// delete はカレンダーオブジェクトリソースを削除する
+func (h *Handler) delete(w http.ResponseWriter, r *http.Request, t *target) error {
+	if t.kind != kindObject {
+		return errorf(http.StatusForbidden, "only calendar object resources can be deleted")
+	}
+
+	obj, err := h.findObject(t.calendarID, t.name)
+	if err != nil {
+		return err
+	}
+	if err := checkPreconditions(r, obj); err != nil {
+		return err
+	}
+
+	if err := h.storage.DeleteEvent(obj.master.ID); err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// checkPreconditions はIf-MatchとIf-None-Matchを評価する（RFC 9110 13.1）
+// objがnilの場合はリソースが存在しないものとして扱う
+func checkPreconditions(r *http.Request, obj *object) error {
+	if ifMatch := r.Header.Get("If-Match"); ifMatch != "" {
+		if obj == nil || !matchETag(ifMatch, obj.etag) {
+			return errorf(http.StatusPreconditionFailed, "If-Match precondition failed")
+		}
+	}
+	if ifNoneMatch := r.Header.Get("If-None-Match"); ifNoneMatch != "" {
+		if obj != nil && matchETag(ifNoneMatch, obj.etag) {
+			return errorf(http.StatusPreconditionFailed, "If-None-Match precondition failed")
+		}
+	}
+	return nil
+}
+
+// matchETag はヘッダーのETagの一覧（または"*"）がetagに一致するかを返す
+func matchETag(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// readBody は上限までの本文を読み込む
+func readBody(r *http.Request) ([]byte, error) {
+	return io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+}
+
+// readXML は本文のXMLをvに読み込む
+// 本文が空の場合はemptyを返す
+func readXML(r *http.Request, v any) (empty bool, err error) {
+	body, err := readBody(r)
+	if err != nil {
+		return false, err
+	}
+	if len(strings.TrimSpace(string(body))) == 0 {
+		return true, nil
+	}
+	if err := xml.Unmarshal(body, v); err != nil {
+		return false, errorf(http.StatusBadRequest, "invalid XML body: %s", err.Error())
+	}
+	return false, nil
+}
@@ -0,0 +1,208 @@
+package caldav
+
+import (
+	"encoding/xml"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/recurrence-scheduler/internal/ical"
+	"github.com/recurrence-scheduler/internal/models"
+	"github.com/recurrence-scheduler/internal/recurrence"
+	"github.com/recurrence-scheduler/internal/storage"
+)
+
+// readPrivileges と readWritePrivileges はDAV:current-user-privilege-setの値
+// 認証の仕組みを持たないため、利用者はすべてのカレンダーを読み書きできる
+const (
+	readPrivileges      = `<D:privilege><D:read/></D:privilege>`
+	readWritePrivileges = `<D:privilege><D:read/></D:privilege><D:privilege><D:write/></D:privilege>` +
+		`<D:privilege><D:write-content/></D:privilege><D:privilege><D:bind/></D:privilege><D:privilege><D:unbind/></D:privilege>`
+)
+
+// propfind はPROPFINDを処理する（RFC 4918 9.1）
+// Depth: infinityは1として扱う
+func (h *Handler) propfind(w http.ResponseWriter, r *http.Request, t *target) error {
+	var req propfindRequest
+	empty, err := readXML(r, &req)
+	if err != nil {
+		return err
+	}
+
+	// nilはallpropを表す
+	var names []xml.Name
+	propName := false
+	switch {
+	case empty || req.AllProp != nil:
+	case req.PropName != nil:
+		propName = true
+	case req.Prop != nil:
+		names = req.Prop.names()
+	}
+
+	resources, err := h.collect(t, r.Header.Get("Depth") != "0")
+	if err != nil {
+		return err
+	}
+
+	ms := &multistatus{}
+	for _, res := range resources {
+		resp := &response{href: res.href}
+		if propName {
+			resp.found = propNames(res.props)
+		} else {
+			resp.found, resp.missing = selectProps(res.props, names)
+		}
+		ms.responses = append(ms.responses, resp)
+	}
+
+	ms.write(w)
+	return nil
+}
+
+// resource はPROPFINDの対象となる1リソースとそのプロパティ
+type resource struct {
+	href  string
+	props []property
+}
+
+// collect は対象のリソースと、depthがtrueの場合はその直下のリソースを集める
+func (h *Handler) collect(t *target, depth bool) ([]*resource, error) {
+	switch t.kind {
+	case kindRoot:
+		resources := []*resource{{href: h.prefix + "/", props: h.rootProps(true)}}
+		if depth {
+			resources = append(resources,
+				&resource{href: h.principalHref(), props: h.principalProps()},
+				&resource{href: h.homeHref(), props: h.rootProps(false)})
+		}
+		return resources, nil
+
+	case kindPrincipal:
+		return []*resource{{href: h.principalHref(), props: h.principalProps()}}, nil
+
+	case kindHome:
+		resources := []*resource{{href: h.homeHref(), props: h.rootProps(false)}}
+		if depth {
+			calendars, err := h.storage.ListCalendars(storage.Cursor{}, -1)
+			if err != nil {
+				return nil, err
+			}
+			for _, cal := range calendars {
+				props, err := h.calendarProps(cal)
+				if err != nil {
+					return nil, err
+				}
+				resources = append(resources, &resource{href: h.calendarHref(cal.ID), props: props})
+			}
+		}
+		return resources, nil
+
+	case kindCalendar:
+		cal, err := h.calendar(t.calendarID)
+		if err != nil {
+			return nil, err
+		}
+		props, err := h.calendarProps(cal)
+		if err != nil {
+			return nil, err
+		}
+		resources := []*resource{{href: h.calendarHref(cal.ID), props: props}}
+		if depth {
+			objects, err := h.listObjects(cal.ID)
+			if err != nil {
+				return nil, err
+			}
+			for _, obj := range objects {
+				resources = append(resources, &resource{href: h.objectHref(cal.ID, obj.name()), props: objectProps(obj, nil)})
+			}
+		}
+		return resources, nil
+
+	default:
+		obj, err := h.findObject(t.calendarID, t.name)
+		if err != nil {
+			return nil, err
+		}
+		return []*resource{{href: h.objectHref(t.calendarID, obj.name()), props: objectProps(obj, nil)}}, nil
+	}
+}
+
+// commonProps はプリンシパルの発見（RFC 5397, RFC 4791 6.2.1）に必要なプロパティ
+func (h *Handler) commonProps() []property {
+	return []property{
+		{davName("current-user-principal"), hrefValue(h.principalHref())},
+		{calDAVName("calendar-home-set"), hrefValue(h.homeHref())},
+		{davName("principal-collection-set"), hrefValue(h.principalHref())},
+	}
+}
+
+// rootProps はルートまたはカレンダーホームのプロパティ
+func (h *Handler) rootProps(root bool) []property {
+	name := "Calendars"
+	if root {
+		name = "CalDAV"
+	}
+	return append(h.commonProps(),
+		property{davName("resourcetype"), "<D:collection/>"},
+		property{davName("displayname"), escape(name)},
+		property{davName("current-user-privilege-set"), readPrivileges},
+	)
+}
+
+// principalProps はプリンシパルのプロパティ
+func (h *Handler) principalProps() []property {
+	return append(h.commonProps(),
+		property{davName("resourcetype"), "<D:principal/>"},
+		property{davName("displayname"), "Owner"},
+		property{davName("principal-URL"), hrefValue(h.principalHref())},
+		property{davName("current-user-privilege-set"), readPrivileges},
+	)
+}
+
+// calendarProps はカレンダーコレクションのプロパティ
+func (h *Handler) calendarProps(cal *models.Calendar) ([]property, error) {
+	seq, err := h.storage.LatestChange(cal.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	year := time.Now().Year()
+	vcal := ical.NewComponent("VCALENDAR")
+	vcal.Add("VERSION", ical.TypeText, "2.0")
+	vcal.Add("PRODID", ical.TypeText, ical.ProdID)
+	vcal.Children = append(vcal.Children, ical.VTimezone(recurrence.Location(cal.Timezone), year, year))
+
+	props := append(h.commonProps(),
+		property{davName("resourcetype"), "<D:collection/><C:calendar/>"},
+		property{davName("displayname"), escape(cal.Name)},
+		property{calDAVName("calendar-description"), escape(cal.Description)},
+		property{calDAVName("calendar-timezone"), escape(string(ical.Marshal(vcal)))},
+		property{calDAVName("supported-calendar-component-set"), `<C:comp name="VEVENT"/>`},
+		property{calDAVName("supported-calendar-data"), `<C:calendar-data content-type="text/calendar" version="2.0"/>`},
+		property{davName("supported-report-set"),
+			`<D:supported-report><D:report><C:calendar-query/></D:report></D:supported-report>` +
+				`<D:supported-report><D:report><C:calendar-multiget/></D:report></D:supported-report>` +
+				`<D:supported-report><D:report><D:sync-collection/></D:report></D:supported-report>`},
+		property{davName("current-user-privilege-set"), readWritePrivileges},
+		property{davName("sync-token"), escape(syncToken(seq))},
+		property{xml.Name{Space: nsCalendarServer, Local: "getctag"}, escape(strconv.FormatInt(seq, 10))},
+	)
+	return props, nil
+}
+
+// objectProps はカレンダーオブジェクトリソースのプロパティ
+// dataがnilの場合は保存されている内容をcalendar-dataとして返す
+func objectProps(obj *object, data []byte) []property {
+	if data == nil {
+		data = obj.data
+	}
+	return []property{
+		{davName("resourcetype"), ""},
+		{davName("getetag"), escape(obj.etag)},
+		{davName("getcontenttype"), escape(objectContentType)},
+		{davName("getcontentlength"), strconv.Itoa(len(obj.data))},
+		{davName("getlastmodified"), obj.lastModified().UTC().Format(http.TimeFormat)},
+		{calDAVName("calendar-data"), escape(string(data))},
+	}
+}
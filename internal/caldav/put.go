@@ -0,0 +1,186 @@
+package caldav
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/recurrence-scheduler/internal/ical"
+	"github.com/recurrence-scheduler/internal/models"
+	"github.com/recurrence-scheduler/internal/recurrence"
+)
+
+// put はカレンダーオブジェクトリソースを作成または置き換える
+// 保存時にイベントへ変換するため内容はバイト単位では保持されない
+// そのためRFC 4791 5.3.4に従い、応答にETagは含めない
+func (h *Handler) put(w http.ResponseWriter, r *http.Request, t *target) error {
+	if t.kind != kindObject {
+		return errorf(http.StatusMethodNotAllowed, "PUT is only supported for calendar object resources")
+	}
+
+	cal, err := h.storage.GetCalendar(t.calendarID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return errorf(http.StatusConflict, "calendar collection not found")
+	}
+	if err != nil {
+		return err
+	}
+
+	if ct := r.Header.Get("Content-Type"); ct != "" {
+		if mediaType, _, err := mime.ParseMediaType(ct); err != nil || mediaType != "text/calendar" {
+			return preconditionFailed(http.StatusUnsupportedMediaType, calDAVName("supported-calendar-data"),
+				"only text/calendar is supported")
+		}
+	}
+
+	existing, err := h.findObject(cal.ID, t.name)
+	var de *davError
+	if errors.As(err, &de) && de.status == http.StatusNotFound {
+		existing, err = nil, nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := checkPreconditions(r, existing); err != nil {
+		return err
+	}
+
+	body, err := readBody(r)
+	if err != nil {
+		return err
+	}
+	master, overrides, err := h.parseObject(cal, t.name, body, existing)
+	if err != nil {
+		return err
+	}
+
+	if err := h.storage.SaveSeries(master, overrides); err != nil {
+		return err
+	}
+
+	if existing != nil {
+		w.WriteHeader(http.StatusNoContent)
+	} else {
+		w.WriteHeader(http.StatusCreated)
+	}
+	return nil
+}
+
+// parseObject はPUTされたVCALENDARを繰り返しイベントとオーバーライドに変換する
+// existingがあればそのIDと作成日時を引き継ぐ
+func (h *Handler) parseObject(cal *models.Calendar, name string, body []byte, existing *object) (*models.Event, []*models.Event, error) {
+	vcal, err := ical.Parse(bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, preconditionFailed(http.StatusForbidden, calDAVName("valid-calendar-data"), "%s", err.Error())
+	}
+	if vcal.Name != "VCALENDAR" {
+		return nil, nil, preconditionFailed(http.StatusForbidden, calDAVName("valid-calendar-data"), "expected VCALENDAR")
+	}
+	for _, child := range vcal.Children {
+		if child.Name != "VEVENT" && child.Name != "VTIMEZONE" {
+			return nil, nil, preconditionFailed(http.StatusForbidden, calDAVName("supported-calendar-component"),
+				"%s is not supported", child.Name)
+		}
+	}
+
+	_, events, _, errs := ical.Events(vcal, cal.Timezone)
+	if len(events) == 0 {
+		return nil, nil, preconditionFailed(http.StatusForbidden, calDAVName("valid-calendar-object-resource"), "no VEVENT")
+	}
+
+	var imported *models.Event
+	var importedOverrides []*models.Event
+	recurrenceIDs := make(map[int64]bool)
+	for i, e := range events {
+		if errs[i] != nil {
+			return nil, nil, preconditionFailed(http.StatusForbidden, calDAVName("valid-calendar-data"), "%s", errs[i].Error())
+		}
+		if e.UID != events[0].UID {
+			return nil, nil, preconditionFailed(http.StatusForbidden, calDAVName("valid-calendar-object-resource"),
+				"all components must have the same UID")
+		}
+
+		if e.RecurrenceID.IsZero() {
+			if imported != nil {
+				return nil, nil, preconditionFailed(http.StatusForbidden, calDAVName("valid-calendar-object-resource"),
+					"more than one VEVENT without RECURRENCE-ID")
+			}
+			imported = e
+			continue
+		}
+		if recurrenceIDs[e.RecurrenceID.Unix()] {
+			return nil, nil, preconditionFailed(http.StatusForbidden, calDAVName("valid-calendar-object-resource"),
+				"duplicate RECURRENCE-ID")
+		}
+		recurrenceIDs[e.RecurrenceID.Unix()] = true
+		importedOverrides = append(importedOverrides, e)
+	}
+	if imported == nil {
+		return nil, nil, preconditionFailed(http.StatusForbidden, calDAVName("valid-calendar-object-resource"),
+			"a VEVENT without RECURRENCE-ID is required")
+	}
+
+	// UIDはカレンダー内で一意でなければならず、既存のリソースのUIDは変更できない（RFC 4791 5.3.2.1）
+	if other, err := h.storage.GetEventByUID(cal.ID, imported.UID); err == nil {
+		if existing == nil || other.ID != existing.master.ID {
+			return nil, nil, &davError{
+				status:       http.StatusForbidden,
+				precondition: calDAVName("no-uid-conflict"),
+				message:      "UID is already used by " + h.objectHref(cal.ID, resourceName(other)),
+			}
+		}
+	}
+	if existing != nil && ical.UID(existing.master) != imported.UID {
+		return nil, nil, preconditionFailed(http.StatusForbidden, calDAVName("no-uid-conflict"),
+			"the UID of an existing resource cannot be changed")
+	}
+
+	master := models.NewEvent(cal.ID, "", "", imported.DTStart, imported.DTEnd, "", "")
+	if existing != nil {
+		master.ID = existing.master.ID
+		master.CreatedAt = existing.master.CreatedAt
+	}
+	master.ResourceName = name
+	ical.Apply(master, imported, h.storage)
+
+	// APIと同じ検証を通し、APIで更新できないルールを保存しない
+	if violations := recurrence.Validate(master.RRule, master.DTStart); len(violations) > 0 {
+		descriptions := make([]string, len(violations))
+		for i, v := range violations {
+			descriptions[i] = v.Description
+		}
+		return nil, nil, preconditionFailed(http.StatusForbidden, calDAVName("valid-calendar-data"),
+			"invalid RRULE: %s", strings.Join(descriptions, "; "))
+	}
+
+	createdAt := make(map[string]*models.Event)
+	if existing != nil {
+		for _, override := range existing.overrides {
+			createdAt[override.ID] = override
+		}
+	}
+
+	overrides := make([]*models.Event, 0, len(importedOverrides))
+	for _, imported := range importedOverrides {
+		ok, err := recurrence.IsOccurrence(master, imported.RecurrenceID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			return nil, nil, preconditionFailed(http.StatusForbidden, calDAVName("valid-calendar-object-resource"),
+				"RECURRENCE-ID %s is not an occurrence of the recurring event", imported.RecurrenceID.UTC().Format(utcLayout))
+		}
+
+		override := recurrence.NewOverride(master, imported.RecurrenceID)
+		if previous, ok := createdAt[override.ID]; ok {
+			override.CreatedAt = previous.CreatedAt
+		}
+		ical.Apply(override, imported, h.storage)
+		overrides = append(overrides, override)
+	}
+
+	return master, overrides, nil
+}
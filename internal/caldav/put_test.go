@@ -0,0 +1,57 @@
+package caldav
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/recurrence-scheduler/internal/models"
+	"github.com/recurrence-scheduler/internal/storage"
+)
+
+func TestPutValidatesRRule(t *testing.T) {
+	st, err := storage.NewSQLiteStorage(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer st.Close()
+	cal := models.NewCalendar("test", "", "UTC")
+	if err := st.CreateCalendar(cal); err != nil {
+		t.Fatal(err)
+	}
+	h := NewHandler(st, "/dav")
+
+	tests := []struct {
+		name  string
+		rrule string
+		want  int
+	}{
+		{name: "valid", rrule: "FREQ=DAILY;UNTIL=20250110T090000Z", want: http.StatusCreated},
+		{name: "UNTIL before DTSTART", rrule: "FREQ=DAILY;UNTIL=20250101T090000Z", want: http.StatusForbidden},
+		{name: "COUNT and UNTIL", rrule: "FREQ=DAILY;COUNT=3;UNTIL=20250110T090000Z", want: http.StatusForbidden},
+		{name: "numeric BYDAY with WEEKLY", rrule: "FREQ=WEEKLY;BYDAY=2MO", want: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := strings.Join([]string{
+				"BEGIN:VCALENDAR", "VERSION:2.0", "PRODID:-//test//test//EN",
+				"BEGIN:VEVENT", "UID:" + tt.name, "DTSTART:20250106T090000Z", "DTEND:20250106T100000Z", "RRULE:" + tt.rrule, "END:VEVENT",
+				"END:VCALENDAR",
+			}, "\r\n") + "\r\n"
+			req := httptest.NewRequest(http.MethodPut, "/dav/calendars/"+cal.ID+"/"+strings.ReplaceAll(tt.name, " ", "-")+".ics", strings.NewReader(body))
+			req.Header.Set("Content-Type", "text/calendar")
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d: %s", rec.Code, tt.want, rec.Body.String())
+			}
+			if tt.want == http.StatusForbidden && !strings.Contains(rec.Body.String(), "valid-calendar-data") {
+				t.Errorf("body does not name the valid-calendar-data precondition: %s", rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,430 @@
+package caldav
+
+import (
+	"encoding/xml"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/recurrence-scheduler/internal/ical"
+	"github.com/recurrence-scheduler/internal/models"
+	"github.com/recurrence-scheduler/internal/recurrence"
+)
+
+// utcLayout はC:time-rangeやC:expandで使うUTCの日時形式
+const utcLayout = "20060102T150405Z"
+
+// syncTokenPrefix は同期トークンのURIの接頭辞（RFC 6578ではトークンはURIでなければならない）
+const syncTokenPrefix = "urn:x-recurrence-scheduler:sync:"
+
+// syncToken は変更番号から同期トークンを作成する
+func syncToken(seq int64) string {
+	return syncTokenPrefix + strconv.FormatInt(seq, 10)
+}
+
+// report はREPORTを処理する
+// ルート要素によってcalendar-query、calendar-multiget、sync-collectionのいずれかを実行する
+func (h *Handler) report(w http.ResponseWriter, r *http.Request, t *target) error {
+	if t.kind != kindCalendar {
+		return preconditionFailed(http.StatusForbidden, davName("supported-report"), "REPORT is only supported on calendar collections")
+	}
+
+	cal, err := h.calendar(t.calendarID)
+	if err != nil {
+		return err
+	}
+
+	var root struct {
+		XMLName xml.Name
+	}
+	body, err := readBody(r)
+	if err != nil {
+		return err
+	}
+	if err := xml.Unmarshal(body, &root); err != nil {
+		return errorf(http.StatusBadRequest, "invalid XML body: %s", err.Error())
+	}
+
+	switch root.XMLName {
+	case calDAVName("calendar-query"):
+		var query calendarQuery
+		if err := xml.Unmarshal(body, &query); err != nil {
+			return errorf(http.StatusBadRequest, "invalid calendar-query: %s", err.Error())
+		}
+		return h.calendarQuery(w, cal, &query)
+	case calDAVName("calendar-multiget"):
+		var multiget calendarMultiget
+		if err := xml.Unmarshal(body, &multiget); err != nil {
+			return errorf(http.StatusBadRequest, "invalid calendar-multiget: %s", err.Error())
+		}
+		return h.calendarMultiget(w, cal, &multiget)
+	case davName("sync-collection"):
+		var sync syncCollection
+		if err := xml.Unmarshal(body, &sync); err != nil {
+			return errorf(http.StatusBadRequest, "invalid sync-collection: %s", err.Error())
+		}
+		return h.syncCollection(w, cal, &sync)
+	}
+
+	return preconditionFailed(http.StatusForbidden, davName("supported-report"), "unsupported report %s", root.XMLName.Local)
+}
+
+// requestedProps はREPORTで要求されたプロパティ名と、calendar-dataの要求を返す
+// プロパティが指定されていなければallpropとして扱う
+func requestedProps(prop *propRequest) ([]xml.Name, *calendarDataRequest) {
+	if prop == nil {
+		return nil, nil
+	}
+	return prop.names(), prop.CalendarData
+}
+
+// objectResponse はリソースの応答を作成する
+// calendar-dataでC:expandが指定されていれば、期間内のインスタンスに展開した内容を返す
+func (h *Handler) objectResponse(cal *models.Calendar, obj *object, names []xml.Name, dataReq *calendarDataRequest) (*response, error) {
+	var data []byte
+	if dataReq != nil && dataReq.Expand != nil {
+		start, end, err := parseTimeRange(dataReq.Expand)
+		if err != nil {
+			return nil, err
+		}
+		if start.IsZero() || end.IsZero() {
+			return nil, preconditionFailed(http.StatusForbidden, calDAVName("valid-filter"), "expand requires start and end")
+		}
+		if data, err = expandObject(obj, start, end); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &response{href: h.objectHref(cal.ID, obj.name())}
+	resp.found, resp.missing = selectProps(objectProps(obj, data), names)
+	return resp, nil
+}
+
+// calendarQuery はC:calendar-queryを処理する（RFC 4791 7.8）
+// 繰り返しイベントはインスタンスに展開してからC:time-rangeと照合する
+func (h *Handler) calendarQuery(w http.ResponseWriter, cal *models.Calendar, query *calendarQuery) error {
+	filter := query.Filter.CompFilter
+	if filter == nil || filter.Name != "VCALENDAR" {
+		return preconditionFailed(http.StatusForbidden, calDAVName("valid-filter"), "filter must contain a VCALENDAR comp-filter")
+	}
+
+	objects, err := h.listObjects(cal.ID)
+	if err != nil {
+		return err
+	}
+
+	names, dataReq := requestedProps(query.Prop)
+	ms := &multistatus{}
+	for _, obj := range objects {
+		ok, err := matchCalendar(obj, filter)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		resp, err := h.objectResponse(cal, obj, names, dataReq)
+		if err != nil {
+			return err
+		}
+		ms.responses = append(ms.responses, resp)
+	}
+
+	ms.write(w)
+	return nil
+}
+
+// matchCalendar はリソースがVCALENDARのcomp-filterに一致するかを返す
+func matchCalendar(obj *object, filter *compFilter) (bool, error) {
+	if filter.IsNotDefined != nil {
+		return false, nil
+	}
+	if len(filter.PropFilters) > 0 || filter.TimeRange != nil {
+		return false, preconditionFailed(http.StatusForbidden, calDAVName("supported-filter"), "unsupported filter on VCALENDAR")
+	}
+
+	for i := range filter.CompFilters {
+		ok, err := matchEvent(obj, &filter.CompFilters[i])
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// matchEvent はリソースのVEVENTがcomp-filterに一致するかを返す
+// 対応するのはVEVENTのtime-rangeと、VALARMなど保持しないコンポーネントの有無のみ
+func matchEvent(obj *object, filter *compFilter) (bool, error) {
+	if filter.Name != "VEVENT" {
+		// VEVENT以外のコンポーネントは保存しない
+		return filter.IsNotDefined != nil, nil
+	}
+	if filter.IsNotDefined != nil {
+		return false, nil
+	}
+	if len(filter.PropFilters) > 0 {
+		return false, preconditionFailed(http.StatusForbidden, calDAVName("supported-filter"), "prop-filter is not supported")
+	}
+
+	for _, sub := range filter.CompFilters {
+		// VEVENTの子コンポーネント（VALARMなど）は保存しない
+		if sub.IsNotDefined == nil {
+			return false, nil
+		}
+	}
+
+	if filter.TimeRange == nil {
+		return true, nil
+	}
+	start, end, err := parseTimeRange(filter.TimeRange)
+	if err != nil {
+		return false, err
+	}
+	return occursIn(obj, start, end)
+}
+
+// farFuture は終了が指定されていない期間の代わりに使う日時
+var farFuture = time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)
+
+// occursIn はリソースのいずれかのインスタンスが期間と重なるかを返す（RFC 4791 9.9）
+// startとendのゼロ値はそれぞれ制限がないことを表す
+func occursIn(obj *object, start, end time.Time) (bool, error) {
+	if end.IsZero() {
+		// 終わりのない繰り返しを展開しないよう、開始以降のインスタンスの有無だけを調べる
+		set, err := recurrence.NewSet(obj.master)
+		if err != nil {
+			return false, err
+		}
+		if !set.After(start.Add(-obj.master.DTEnd.Sub(obj.master.DTStart)), true).IsZero() {
+			return true, nil
+		}
+		for _, override := range obj.overrides {
+			if overlaps(override, start, farFuture) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	if start.IsZero() {
+		start = earliest(obj)
+	}
+	instances, err := recurrence.Expand(obj.master, obj.overrides, start, end)
+	if err != nil {
+		return false, err
+	}
+	for _, instance := range instances {
+		if overlaps(instance, start, end) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// overlaps はRFC 4791 9.9の規則でイベントが[start, end)と重なるかを返す
+func overlaps(e *models.Event, start, end time.Time) bool {
+	if e.DTEnd.After(e.DTStart) {
+		return start.Before(e.DTEnd) && end.After(e.DTStart)
+	}
+	return !start.After(e.DTStart) && end.After(e.DTStart)
+}
+
+// earliest はリソース内で最も早いインスタンスの開始時刻を返す
+func earliest(obj *object) time.Time {
+	t := obj.master.DTStart
+	for _, rdate := range obj.master.RDates {
+		if rdate.Before(t) {
+			t = rdate
+		}
+	}
+	for _, override := range obj.overrides {
+		if override.DTStart.Before(t) {
+			t = override.DTStart
+		}
+	}
+	return t
+}
+
+// expandObject は期間内のインスタンスをRRULEを持たないVEVENTとして出力する（RFC 4791 9.6.5）
+// 展開した日時はUTCで表す
+func expandObject(obj *object, start, end time.Time) ([]byte, error) {
+	instances, err := recurrence.Expand(obj.master, obj.overrides, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	var expanded []*models.Event
+	for _, instance := range instances {
+		if !overlaps(instance, start, end) {
+			continue
+		}
+		e := *instance
+		e.UID = ical.UID(obj.master)
+		e.Timezone = "UTC"
+		e.RRule, e.ExDates, e.RDates = "", nil, nil
+		expanded = append(expanded, &e)
+	}
+
+	return ical.Marshal(ical.ObjectComponent(expanded)), nil
+}
+
+// parseTimeRange はC:time-rangeの属性を解析する
+func parseTimeRange(tr *timeRange) (start, end time.Time, err error) {
+	if tr.Start == "" && tr.End == "" {
+		return start, end, preconditionFailed(http.StatusForbidden, calDAVName("valid-filter"), "time-range requires start or end")
+	}
+	if tr.Start != "" {
+		if start, err = time.Parse(utcLayout, tr.Start); err != nil {
+			return start, end, preconditionFailed(http.StatusForbidden, calDAVName("valid-filter"), "invalid time-range start %q", tr.Start)
+		}
+	}
+	if tr.End != "" {
+		if end, err = time.Parse(utcLayout, tr.End); err != nil {
+			return start, end, preconditionFailed(http.StatusForbidden, calDAVName("valid-filter"), "invalid time-range end %q", tr.End)
+		}
+	}
+	if !start.IsZero() && !end.IsZero() && !end.After(start) {
+		return start, end, preconditionFailed(http.StatusForbidden, calDAVName("valid-filter"), "time-range end must be after start")
+	}
+	return start, end, nil
+}
+
+// calendarMultiget はC:calendar-multigetを処理する（RFC 4791 7.9）
+func (h *Handler) calendarMultiget(w http.ResponseWriter, cal *models.Calendar, multiget *calendarMultiget) error {
+	names, dataReq := requestedProps(multiget.Prop)
+
+	ms := &multistatus{}
+	for _, href := range multiget.Hrefs {
+		href = strings.TrimSpace(href)
+		t, ok := h.parseHref(href)
+		if !ok || t.kind != kindObject || t.calendarID != cal.ID {
+			ms.responses = append(ms.responses, &response{href: href, status: http.StatusNotFound})
+			continue
+		}
+
+		obj, err := h.findObject(cal.ID, t.name)
+		if err != nil {
+			ms.responses = append(ms.responses, &response{href: href, status: http.StatusNotFound})
+			continue
+		}
+
+		resp, err := h.objectResponse(cal, obj, names, dataReq)
+		if err != nil {
+			return err
+		}
+		ms.responses = append(ms.responses, resp)
+	}
+
+	ms.write(w)
+	return nil
+}
+
+// parseHref はDAV:hrefの値（絶対URLまたはパス）を解析する
+func (h *Handler) parseHref(href string) (*target, bool) {
+	if i := strings.Index(href, "://"); i >= 0 {
+		rest := href[i+3:]
+		j := strings.IndexByte(rest, '/')
+		if j < 0 {
+			return nil, false
+		}
+		href = rest[j:]
+	}
+	return h.parsePath(href)
+}
+
+// syncCollection はDAV:sync-collectionを処理する（RFC 6578 3.2）
+// 同期トークンは変更履歴の番号で、トークン以降に変更されたリソースと削除されたリソースを返す
+func (h *Handler) syncCollection(w http.ResponseWriter, cal *models.Calendar, sync *syncCollection) error {
+	if sync.SyncLevel != "" && sync.SyncLevel != "1" && sync.SyncLevel != "infinite" {
+		return errorf(http.StatusBadRequest, "invalid sync-level %q", sync.SyncLevel)
+	}
+
+	latest, err := h.storage.LatestChange(cal.ID)
+	if err != nil {
+		return err
+	}
+
+	var since int64
+	if sync.SyncToken != "" {
+		seq, err := strconv.ParseInt(strings.TrimPrefix(sync.SyncToken, syncTokenPrefix), 10, 64)
+		if err != nil || !strings.HasPrefix(sync.SyncToken, syncTokenPrefix) || seq < 0 || seq > latest {
+			return preconditionFailed(http.StatusForbidden, davName("valid-sync-token"), "invalid sync-token")
+		}
+		since = seq
+	}
+
+	names, dataReq := requestedProps(sync.Prop)
+	ms := &multistatus{syncToken: syncToken(latest)}
+
+	// 初回の同期ではすべてのリソースを返す
+	if since == 0 {
+		objects, err := h.listObjects(cal.ID)
+		if err != nil {
+			return err
+		}
+		for _, obj := range objects {
+			resp, err := h.objectResponse(cal, obj, names, dataReq)
+			if err != nil {
+				return err
+			}
+			ms.responses = append(ms.responses, resp)
+		}
+		ms.write(w)
+		return nil
+	}
+
+	changes, err := h.storage.ListChanges(cal.ID, since)
+	if err != nil {
+		return err
+	}
+
+	// 同じイベントの複数の変更は最後の状態だけを返す
+	var order []string
+	lastChange := make(map[string]*models.Change)
+	for _, change := range changes {
+		if change.Seq > latest {
+			break
+		}
+		if _, ok := lastChange[change.EventID]; !ok {
+			order = append(order, change.EventID)
+		}
+		lastChange[change.EventID] = change
+	}
+
+	changed := make(map[string]bool)
+	var deleted []*models.Change
+	for _, eventID := range order {
+		master, err := h.storage.GetEvent(eventID)
+		if err != nil || master.CalendarID != cal.ID {
+			if change := lastChange[eventID]; change.Deleted {
+				deleted = append(deleted, change)
+			}
+			continue
+		}
+
+		obj, err := h.loadObject(master)
+		if err != nil {
+			return err
+		}
+		resp, err := h.objectResponse(cal, obj, names, dataReq)
+		if err != nil {
+			return err
+		}
+		changed[resp.href] = true
+		ms.responses = append(ms.responses, resp)
+	}
+
+	for _, change := range deleted {
+		href := h.objectHref(cal.ID, resourceName(&models.Event{ID: change.EventID, UID: change.UID, ResourceName: change.ResourceName}))
+		// 削除後に同じ名前で作り直されたリソースは変更として返している
+		if changed[href] {
+			continue
+		}
+		ms.responses = append(ms.responses, &response{href: href, status: http.StatusNotFound})
+	}
+
+	ms.write(w)
+	return nil
+}
@@ -0,0 +1,295 @@
+package caldav
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// XML名前空間
+const (
+	nsDAV            = "DAV:"
+	nsCalDAV         = "urn:ietf:params:xml:ns:caldav"
+	nsCalendarServer = "http://calendarserver.org/ns/"
+)
+
+// prefixes は応答で使用する名前空間の接頭辞
+var prefixes = map[string]string{
+	nsDAV:            "D",
+	nsCalDAV:         "C",
+	nsCalendarServer: "CS",
+}
+
+func davName(local string) xml.Name    { return xml.Name{Space: nsDAV, Local: local} }
+func calDAVName(local string) xml.Name { return xml.Name{Space: nsCalDAV, Local: local} }
+
+// anyElement は名前だけを読み取る任意の要素
+type anyElement struct {
+	XMLName xml.Name
+}
+
+// propRequest はDAV:propで要求されたプロパティ名の一覧
+type propRequest struct {
+	Names        []anyElement         `xml:",any"`
+	CalendarData *calendarDataRequest `xml:"urn:ietf:params:xml:ns:caldav calendar-data"`
+}
+
+// names は要求されたプロパティ名を返す
+func (p *propRequest) names() []xml.Name {
+	var names []xml.Name
+	for _, n := range p.Names {
+		names = append(names, n.XMLName)
+	}
+	if p.CalendarData != nil {
+		names = append(names, calDAVName("calendar-data"))
+	}
+	return names
+}
+
+// calendarDataRequest はC:calendar-dataの要求（RFC 4791 9.6）
+// 展開（C:expand）のみに対応し、C:compによるプロパティの絞り込みは行わない
+type calendarDataRequest struct {
+	Expand *timeRange `xml:"urn:ietf:params:xml:ns:caldav expand"`
+}
+
+// timeRange はC:time-rangeおよびC:expandの期間（UTCの日時）
+type timeRange struct {
+	Start string `xml:"start,attr"`
+	End   string `xml:"end,attr"`
+}
+
+// propfindRequest はPROPFINDの本文（RFC 4918 14.20）
+type propfindRequest struct {
+	XMLName  xml.Name     `xml:"DAV: propfind"`
+	AllProp  *struct{}    `xml:"DAV: allprop"`
+	PropName *struct{}    `xml:"DAV: propname"`
+	Prop     *propRequest `xml:"DAV: prop"`
+}
+
+// calendarQuery はC:calendar-query REPORTの本文（RFC 4791 7.8）
+type calendarQuery struct {
+	XMLName xml.Name     `xml:"urn:ietf:params:xml:ns:caldav calendar-query"`
+	AllProp *struct{}    `xml:"DAV: allprop"`
+	Prop    *propRequest `xml:"DAV: prop"`
+	Filter  struct {
+		CompFilter *compFilter `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+	} `xml:"urn:ietf:params:xml:ns:caldav filter"`
+}
+
+// compFilter はC:comp-filter（RFC 4791 9.7.1）
+type compFilter struct {
+	Name         string       `xml:"name,attr"`
+	IsNotDefined *struct{}    `xml:"urn:ietf:params:xml:ns:caldav is-not-defined"`
+	TimeRange    *timeRange   `xml:"urn:ietf:params:xml:ns:caldav time-range"`
+	CompFilters  []compFilter `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+	PropFilters  []anyElement `xml:"urn:ietf:params:xml:ns:caldav prop-filter"`
+}
+
+// calendarMultiget はC:calendar-multiget REPORTの本文（RFC 4791 7.9）
+type calendarMultiget struct {
+	XMLName xml.Name     `xml:"urn:ietf:params:xml:ns:caldav calendar-multiget"`
+	AllProp *struct{}    `xml:"DAV: allprop"`
+	Prop    *propRequest `xml:"DAV: prop"`
+	Hrefs   []string     `xml:"DAV: href"`
+}
+
+// syncCollection はDAV:sync-collection REPORTの本文（RFC 6578 6.1）
+type syncCollection struct {
+	XMLName   xml.Name     `xml:"DAV: sync-collection"`
+	SyncToken string       `xml:"DAV: sync-token"`
+	SyncLevel string       `xml:"DAV: sync-level"`
+	Prop      *propRequest `xml:"DAV: prop"`
+}
+
+// property は応答に含めるプロパティ（値は整形済みのXML）
+type property struct {
+	name  xml.Name
+	value string
+}
+
+// response はmultistatus内の1リソース分の応答
+// statusが0以外の場合はプロパティを含めず、リソース全体の状態として返す
+type response struct {
+	href    string
+	found   []property
+	missing []xml.Name
+	status  int
+}
+
+// multistatus はDAV:multistatusの応答を組み立てる
+type multistatus struct {
+	responses []*response
+	syncToken string
+}
+
+// write は207 Multi-Statusとして応答を書き出す
+func (ms *multistatus) write(w http.ResponseWriter) {
+	var b bytes.Buffer
+	b.WriteString(xml.Header)
+	b.WriteString(`<D:multistatus xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav" xmlns:CS="http://calendarserver.org/ns/">`)
+	for _, resp := range ms.responses {
+		b.WriteString("<D:response>")
+		b.WriteString("<D:href>" + escape(resp.href) + "</D:href>")
+		if resp.status != 0 {
+			b.WriteString("<D:status>" + statusLine(resp.status) + "</D:status>")
+		} else {
+			writePropstat(&b, resp.found, nil, http.StatusOK)
+			writePropstat(&b, nil, resp.missing, http.StatusNotFound)
+		}
+		b.WriteString("</D:response>")
+	}
+	if ms.syncToken != "" {
+		b.WriteString("<D:sync-token>" + escape(ms.syncToken) + "</D:sync-token>")
+	}
+	b.WriteString("</D:multistatus>")
+
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(http.StatusMultiStatus)
+	w.Write(b.Bytes())
+}
+
+func writePropstat(b *bytes.Buffer, found []property, missing []xml.Name, status int) {
+	if len(found) == 0 && len(missing) == 0 {
+		return
+	}
+
+	b.WriteString("<D:propstat><D:prop>")
+	for _, p := range found {
+		writeElement(b, p.name, p.value)
+	}
+	for _, name := range missing {
+		writeElement(b, name, "")
+	}
+	b.WriteString("</D:prop><D:status>" + statusLine(status) + "</D:status></D:propstat>")
+}
+
+// writeElement は要素を書き出す
+// 既知の名前空間は接頭辞を、それ以外は要素ごとに名前空間を宣言する
+func writeElement(b *bytes.Buffer, name xml.Name, value string) {
+	tag, decl := name.Local, ""
+	if prefix, ok := prefixes[name.Space]; ok {
+		tag = prefix + ":" + name.Local
+	} else if name.Space != "" {
+		tag = "X:" + name.Local
+		decl = ` xmlns:X="` + escape(name.Space) + `"`
+	}
+
+	if value == "" {
+		b.WriteString("<" + tag + decl + "/>")
+		return
+	}
+	b.WriteString("<" + tag + decl + ">" + value + "</" + tag + ">")
+}
+
+func statusLine(code int) string {
+	return fmt.Sprintf("HTTP/1.1 %d %s", code, http.StatusText(code))
+}
+
+// escape は文字列をXMLのテキストとしてエスケープする
+// calendar-dataを読みやすくするためLFはそのまま残す（CRはXMLの改行の正規化で失われないよう文字参照にする）
+func escape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return strings.ReplaceAll(b.String(), "&#xA;", "\n")
+}
+
+// hrefValue はDAV:hrefを含むプロパティ値を作成する
+func hrefValue(hrefs ...string) string {
+	var b strings.Builder
+	for _, href := range hrefs {
+		b.WriteString("<D:href>" + escape(href) + "</D:href>")
+	}
+	return b.String()
+}
+
+// selectProps は利用可能なプロパティから要求されたものを選び、存在しないものを別に返す
+// namesがnilの場合はallpropとして、allpropに含めないプロパティ以外をすべて返す
+func selectProps(available []property, names []xml.Name) ([]property, []xml.Name) {
+	if names == nil {
+		var found []property
+		for _, p := range available {
+			if !excludedFromAllProp[p.name] {
+				found = append(found, p)
+			}
+		}
+		return found, nil
+	}
+
+	byName := make(map[xml.Name]property, len(available))
+	for _, p := range available {
+		byName[p.name] = p
+	}
+
+	var found []property
+	var missing []xml.Name
+	for _, name := range names {
+		if p, ok := byName[name]; ok {
+			found = append(found, p)
+		} else {
+			missing = append(missing, name)
+		}
+	}
+	return found, missing
+}
+
+// propNames はPROPFINDのpropnameに対する応答として、値を空にしたプロパティ名の一覧を返す
+func propNames(available []property) []property {
+	names := make([]property, len(available))
+	for i, p := range available {
+		names[i] = property{name: p.name}
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i].name.Local < names[j].name.Local })
+	return names
+}
+
+// excludedFromAllProp はallpropで返さないプロパティ（RFC 4791 9.6, RFC 6578 4）
+var excludedFromAllProp = map[xml.Name]bool{
+	calDAVName("calendar-data"):     true,
+	davName("sync-token"):           true,
+	calDAVName("calendar-timezone"): true,
+}
+
+// davError はDAV:errorの前提条件を伴うエラー応答
+type davError struct {
+	status       int
+	precondition xml.Name
+	message      string
+}
+
+func (e *davError) Error() string {
+	return e.message
+}
+
+// write はエラーを書き出す
+// 前提条件がある場合はDAV:error要素を本文に含める（RFC 4918 16）
+func (e *davError) write(w http.ResponseWriter) {
+	if e.precondition.Local == "" {
+		http.Error(w, e.message, e.status)
+		return
+	}
+
+	var b bytes.Buffer
+	b.WriteString(xml.Header)
+	b.WriteString(`<D:error xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav">`)
+	writeElement(&b, e.precondition, "")
+	if e.message != "" {
+		b.WriteString("<D:responsedescription>" + escape(e.message) + "</D:responsedescription>")
+	}
+	b.WriteString("</D:error>")
+
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(e.status)
+	w.Write(b.Bytes())
+}
+
+func errorf(status int, format string, args ...any) *davError {
+	return &davError{status: status, message: fmt.Sprintf(format, args...)}
+}
+
+// preconditionFailed は前提条件の違反を表すエラーを作成する
+func preconditionFailed(status int, precondition xml.Name, format string, args ...any) *davError {
+	return &davError{status: status, precondition: precondition, message: fmt.Sprintf(format, args...)}
+}
@@ -0,0 +1,466 @@
+// Package cron はcron式を、実行時刻が一致するRFC 5545のRRULEに変換する
+package cron
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// macros はVixie cronの@で始まる短縮形
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var monthNames = []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}
+
+var weekdayNames = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+
+// rruleWeekdays はcronの曜日番号（0が日曜日）に対応するBYDAYの値
+var rruleWeekdays = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// daysInMonth は各月の最大の日数（2月はうるう年の日数）
+var daysInMonth = []int{31, 29, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+
+// weekdays は月曜日から金曜日までの曜日番号
+var weekdays = []int{1, 2, 3, 4, 5}
+
+// maxSetPos はBYSETPOSに指定できる位置の上限（RFC 5545 3.3.10）
+const maxSetPos = 366
+
+// Error はcron式を解析・変換できない理由を表す
+type Error struct {
+	Field   string // 問題のあるフィールド名（"minute"、"day-of-month"など。式全体の場合は空）
+	Message string
+}
+
+func (e *Error) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return e.Field + " field: " + e.Message
+}
+
+func errorf(field, format string, args ...any) error {
+	return &Error{Field: field, Message: fmt.Sprintf(format, args...)}
+}
+
+// nthWeekday は月の第n曜日（nが-1の場合は最終曜日）
+type nthWeekday struct {
+	weekday, n int
+}
+
+// daySpec はRRULEで表す日の条件
+type daySpec struct {
+	monthDays []int        // BYMONTHDAY（負の値は月末から数える。nilは制限なし）
+	weekdays  []int        // BYDAY（nilは制限なし）
+	nth       []nthWeekday // 序数付きのBYDAY（FREQ=MONTHLYが必要）
+	setPos    int          // 月の中で条件に一致する最初（1）または最後（-1）の日だけにする（0は制限なし。FREQ=MONTHLYが必要）
+}
+
+// field は秒・分・時・月のフィールドの解析結果
+type field struct {
+	values []int
+	all    bool // 範囲のすべての値を含む
+}
+
+// ToRRule はcron式を、実行時刻がcron式と一致するRRULE（"RRULE:"を含まない）に変換する
+//
+// 5フィールド（分 時 日 月 曜日）と、先頭に秒を加えた6フィールドの形式、@dailyなどの短縮形に対応する。
+// 1つのRRULEで表せない式は拒否する。日と曜日の両方を指定した式（Vixie cronではどちらかに一致する日に実行する）と、
+// 1W・LW以外のW（土曜日なら前日、日曜日なら翌日に移動する）がこれにあたる。
+func ToRRule(expr string) (string, error) {
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "@") {
+		expanded, ok := macros[strings.ToLower(expr)]
+		if !ok {
+			return "", errorf("", "%s cannot be represented as a recurrence rule", expr)
+		}
+		expr = expanded
+	}
+
+	fields := strings.Fields(expr)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	case 7:
+		return "", errorf("", "the year field is not supported; use 5 fields (minute hour day-of-month month day-of-week) or 6 with seconds first")
+	default:
+		return "", errorf("", "expected 5 or 6 fields, got %d", len(fields))
+	}
+
+	second, err := parseField("second", fields[0], 0, 59, nil)
+	if err != nil {
+		return "", err
+	}
+	minute, err := parseField("minute", fields[1], 0, 59, nil)
+	if err != nil {
+		return "", err
+	}
+	hour, err := parseField("hour", fields[2], 0, 23, nil)
+	if err != nil {
+		return "", err
+	}
+	month, err := parseField("month", fields[4], 1, 12, monthNames)
+	if err != nil {
+		return "", err
+	}
+	day, ok, err := parseDays(fields[3], fields[5])
+	if err != nil {
+		return "", err
+	}
+	if !ok || !canOccur(day, month) {
+		return "", errorf("", "%q never matches any date", expr)
+	}
+
+	// BYSETPOSは月の中のすべての実行時刻に番号を振るため、1日の実行回数分の位置を指定する
+	if perDay := len(second.values) * len(minute.values) * len(hour.values); day.setPos != 0 && perDay > maxSetPos {
+		return "", errorf("day-of-month", "1W and LW can run at most %d times a day, got %d", maxSetPos, perDay)
+	}
+	return formatRule(second, minute, hour, month, day), nil
+}
+
+// parseField は数値のフィールドを解析する（namesは1つ目の値minに対応する名前）
+// リスト（,）、範囲（-）、刻み（/）、*に対応する
+func parseField(name, spec string, min, max int, names []string) (field, error) {
+	seen := make(map[int]bool)
+	for _, item := range strings.Split(spec, ",") {
+		values, err := parseItem(name, item, min, max, names)
+		if err != nil {
+			return field{}, err
+		}
+		for _, v := range values {
+			seen[v] = true
+		}
+	}
+
+	f := field{all: len(seen) == max-min+1}
+	for v := range seen {
+		f.values = append(f.values, v)
+	}
+	slices.Sort(f.values)
+	return f, nil
+}
+
+// parseItem はリストの1要素（*、a、a-b、それぞれに/stepを付けたもの）を解析する
+func parseItem(name, item string, min, max int, names []string) ([]int, error) {
+	rangeSpec, stepSpec, hasStep := strings.Cut(item, "/")
+	step := 1
+	if hasStep {
+		n, err := strconv.Atoi(stepSpec)
+		if err != nil || n < 1 {
+			return nil, errorf(name, "invalid step %q", stepSpec)
+		}
+		step = n
+	}
+
+	lo, hi := min, max
+	switch {
+	case rangeSpec == "*":
+	case strings.Contains(rangeSpec, "-"):
+		from, to, _ := strings.Cut(rangeSpec, "-")
+		var err error
+		if lo, err = parseValue(name, from, min, max, names); err != nil {
+			return nil, err
+		}
+		if hi, err = parseValue(name, to, min, max, names); err != nil {
+			return nil, err
+		}
+		if lo > hi {
+			return nil, errorf(name, "range %q is reversed; split it into two ranges", rangeSpec)
+		}
+	default:
+		v, err := parseValue(name, rangeSpec, min, max, names)
+		if err != nil {
+			return nil, err
+		}
+		// "a/step"はaから最大値までの刻みを表す
+		lo = v
+		if !hasStep {
+			hi = v
+		}
+	}
+
+	var values []int
+	for v := lo; v <= hi; v += step {
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+// parseValue は数値または名前（JAN、MONなど）を解析する
+func parseValue(name, s string, min, max int, names []string) (int, error) {
+	for i, n := range names {
+		if strings.EqualFold(s, n) {
+			return min + i, nil
+		}
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, errorf(name, "invalid value %q", s)
+	}
+	if v < min || v > max {
+		return 0, errorf(name, "value %d is out of range %d-%d", v, min, max)
+	}
+	return v, nil
+}
+
+// parseDays は日と曜日のフィールドを、実行日と一致する日の条件に変換する（一致する日がなければfalse）
+func parseDays(domSpec, dowSpec string) (daySpec, bool, error) {
+	// Vixie cronは*で始まるフィールドを「制限なし」として扱い、両方が制限ありの場合だけ和集合にする
+	domStar := strings.HasPrefix(domSpec, "*") || domSpec == "?"
+	dowStar := strings.HasPrefix(dowSpec, "*") || dowSpec == "?"
+
+	dom, err := parseMonthDays(domSpec)
+	if err != nil {
+		return daySpec{}, false, err
+	}
+	dow, err := parseWeekdays(dowSpec)
+	if err != nil {
+		return daySpec{}, false, err
+	}
+
+	if !domStar && !dowStar {
+		return daySpec{}, false, errorf("day-of-month", "cannot be combined with a day-of-week; cron runs when either field matches, "+
+			"which has no recurrence rule equivalent; create one event for the day-of-month and another for the day-of-week")
+	}
+	// どちらか一方の条件だけ、または両方の積集合
+	day, ok := intersect(dom, dow)
+	return day, ok, nil
+}
+
+// parseMonthDays は日のフィールドを解析する
+// 数値・範囲・刻みに加えてL（月末）、L-n（月末のn日前）、1W（最初の平日）、LW（最後の平日）に対応する
+func parseMonthDays(spec string) (daySpec, error) {
+	const name = "day-of-month"
+	if spec == "?" {
+		return daySpec{}, nil
+	}
+
+	upper := strings.ToUpper(spec)
+	if strings.Contains(upper, "W") {
+		if strings.Contains(spec, ",") {
+			return daySpec{}, errorf(name, "W cannot be used in a list")
+		}
+		switch upper {
+		case "LW":
+			// 月末が土曜日なら前日、日曜日なら2日前になるため、月の最後の平日と同じ
+			return daySpec{weekdays: weekdays, setPos: -1}, nil
+		case "1W":
+			// 前月にはまたがないため、1日が土曜日なら3日、日曜日なら2日になり、1〜3日の最初の平日と同じ
+			return daySpec{monthDays: []int{1, 2, 3}, weekdays: weekdays, setPos: 1}, nil
+		}
+		day, err := strconv.Atoi(strings.TrimSuffix(upper, "W"))
+		if err != nil || day < 1 || day > 31 {
+			return daySpec{}, errorf(name, "invalid value %q", spec)
+		}
+		return daySpec{}, errorf(name, "W is only supported as 1W and LW; %dW moves to the day before or after depending on the weekday, which has no recurrence rule equivalent", day)
+	}
+
+	var days []int
+	for _, item := range strings.Split(upper, ",") {
+		switch {
+		case item == "L":
+			days = append(days, -1)
+		case strings.HasPrefix(item, "L-"):
+			n, err := strconv.Atoi(item[2:])
+			if err != nil || n < 1 || n > 30 {
+				return daySpec{}, errorf(name, "invalid value %q", item)
+			}
+			days = append(days, -(n + 1))
+		default:
+			values, err := parseItem(name, item, 1, 31, nil)
+			if err != nil {
+				return daySpec{}, err
+			}
+			days = append(days, values...)
+		}
+	}
+
+	slices.Sort(days)
+	days = slices.Compact(days)
+	if len(days) == 31 && days[0] == 1 {
+		return daySpec{}, nil
+	}
+	return daySpec{monthDays: days}, nil
+}
+
+// parseWeekdays は曜日のフィールドを解析する（0と7が日曜日）
+// 数値・名前・範囲・刻みに加えてn#k（第k n曜日）、nL（最終n曜日）に対応する
+func parseWeekdays(spec string) (daySpec, error) {
+	const name = "day-of-week"
+	if spec == "?" || spec == "*" {
+		return daySpec{}, nil
+	}
+
+	var day daySpec
+	seen := make(map[int]bool)
+	for _, item := range strings.Split(strings.ToUpper(spec), ",") {
+		switch {
+		case strings.Contains(item, "#"):
+			w, k, _ := strings.Cut(item, "#")
+			weekday, err := parseWeekday(w)
+			if err != nil {
+				return daySpec{}, err
+			}
+			n, err := strconv.Atoi(k)
+			if err != nil || n < 1 || n > 5 {
+				return daySpec{}, errorf(name, "invalid occurrence %q; use 1-5 after #", k)
+			}
+			day.nth = append(day.nth, nthWeekday{weekday, n})
+		case item == "L":
+			return daySpec{}, errorf(name, "L must follow a weekday, e.g. 5L for the last Friday")
+		case strings.HasSuffix(item, "L"):
+			weekday, err := parseWeekday(strings.TrimSuffix(item, "L"))
+			if err != nil {
+				return daySpec{}, err
+			}
+			day.nth = append(day.nth, nthWeekday{weekday, -1})
+		default:
+			values, err := parseItem(name, item, 0, 7, weekdayNames)
+			if err != nil {
+				return daySpec{}, err
+			}
+			for _, v := range values {
+				seen[v%7] = true
+			}
+		}
+	}
+
+	for v := range seen {
+		day.weekdays = append(day.weekdays, v)
+	}
+	slices.Sort(day.weekdays)
+	if len(day.weekdays) == 7 && len(day.nth) == 0 {
+		return daySpec{}, nil
+	}
+	return day, nil
+}
+
+func parseWeekday(s string) (int, error) {
+	v, err := parseValue("day-of-week", s, 0, 7, weekdayNames)
+	return v % 7, err
+}
+
+// intersect は日の条件と曜日の条件の両方を満たす条件を返す（満たす日がなければfalse）
+func intersect(dom, dow daySpec) (daySpec, bool) {
+	day := daySpec{monthDays: dom.monthDays, nth: dow.nth, weekdays: dom.weekdays, setPos: dom.setPos}
+	switch {
+	case dow.weekdays == nil:
+	case day.weekdays == nil:
+		day.weekdays = dow.weekdays
+	default:
+		var common []int
+		for _, w := range day.weekdays {
+			if slices.Contains(dow.weekdays, w) {
+				common = append(common, w)
+			}
+		}
+		if len(common) == 0 {
+			return day, false
+		}
+		day.weekdays = common
+	}
+
+	// 序数付きの曜日はBYDAYの中で曜日と和集合になるため、日の条件が曜日を制限している場合は表せない
+	// BYSETPOSは曜日で絞り込んだ後の位置になるため、1W・LWを曜日で絞り込んだ場合も表せない
+	if len(day.nth) > 0 && dom.weekdays != nil || dom.setPos != 0 && dow.weekdays != nil {
+		return day, false
+	}
+	return day, true
+}
+
+// canOccur は日の条件がいずれかの月で満たされうるかを返す（31日しかない2月などを除く）
+func canOccur(day daySpec, month field) bool {
+	if day.monthDays == nil {
+		return true
+	}
+	for _, m := range month.values {
+		for _, d := range day.monthDays {
+			if d < 0 && -d <= daysInMonth[m-1] || d > 0 && d <= daysInMonth[m-1] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// formatRule は日の条件と時刻のフィールドからRRULEを組み立てる
+// FREQには制限のない最も細かい単位を使い、それより細かい時刻はすべてBYxxxで列挙する
+func formatRule(second, minute, hour, month field, day daySpec) string {
+	freq := "DAILY"
+	switch {
+	case len(day.nth) > 0 || day.setPos != 0:
+		// 序数付きのBYDAYと、月の中の位置を表すBYSETPOSはFREQ=MONTHLYでしか使えない
+		freq = "MONTHLY"
+	case second.all:
+		freq = "SECONDLY"
+	case minute.all:
+		freq = "MINUTELY"
+	case hour.all:
+		freq = "HOURLY"
+	}
+
+	parts := []string{"FREQ=" + freq}
+	add := func(name string, values []string) {
+		if len(values) > 0 {
+			parts = append(parts, name+"="+strings.Join(values, ","))
+		}
+	}
+	ints := func(f field, coarser bool) []string {
+		// FREQより粗い単位は制限がある場合だけ指定する
+		if coarser && f.all {
+			return nil
+		}
+		return formatInts(f.values)
+	}
+
+	add("BYMONTH", ints(month, true))
+	add("BYMONTHDAY", formatInts(day.monthDays))
+	var byDay []string
+	for _, w := range day.weekdays {
+		byDay = append(byDay, rruleWeekdays[w])
+	}
+	for _, nth := range day.nth {
+		byDay = append(byDay, strconv.Itoa(nth.n)+rruleWeekdays[nth.weekday])
+	}
+	add("BYDAY", byDay)
+	add("BYHOUR", ints(hour, freq == "SECONDLY" || freq == "MINUTELY" || freq == "HOURLY"))
+	add("BYMINUTE", ints(minute, freq == "SECONDLY" || freq == "MINUTELY"))
+	add("BYSECOND", ints(second, freq == "SECONDLY"))
+	add("BYSETPOS", formatInts(setPositions(day.setPos, len(second.values)*len(minute.values)*len(hour.values))))
+	return strings.Join(parts, ";")
+}
+
+// setPositions は1日にperDay回実行する場合に、最初または最後の日のすべての実行時刻を表すBYSETPOSの位置を返す
+// BYSETPOSは月の中のすべての実行時刻に時刻順で番号を振るため、最初の日は1〜perDay、最後の日は-perDay〜-1になる
+func setPositions(setPos, perDay int) []int {
+	if setPos == 0 {
+		return nil
+	}
+	positions := make([]int, perDay)
+	for i := range positions {
+		if setPos > 0 {
+			positions[i] = i + 1
+		} else {
+			positions[i] = i - perDay
+		}
+	}
+	return positions
+}
+
+func formatInts(values []int) []string {
+	var s []string
+	for _, v := range values {
+		s = append(s, strconv.Itoa(v))
+	}
+	return s
+}
@@ -0,0 +1,130 @@
+package cron
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/teambition/rrule-go"
+)
+
+func TestToRRule(t *testing.T) {
+	const midnight = ";BYHOUR=0;BYMINUTE=0;BYSECOND=0"
+
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"0 9 * * 1-5", "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR;BYHOUR=9;BYMINUTE=0;BYSECOND=0"},
+		{"*/15 * * * *", "FREQ=HOURLY;BYMINUTE=0,15,30,45;BYSECOND=0"},
+		{"30 0 8 1 * *", "FREQ=DAILY;BYMONTHDAY=1;BYHOUR=8;BYMINUTE=0;BYSECOND=30"},
+		{"@daily", "FREQ=DAILY" + midnight},
+		{"@weekly", "FREQ=DAILY;BYDAY=SU" + midnight},
+		{"0 0 * * 7", "FREQ=DAILY;BYDAY=SU" + midnight},
+		{"0 0 L * *", "FREQ=DAILY;BYMONTHDAY=-1" + midnight},
+		{"0 0 L-3 * *", "FREQ=DAILY;BYMONTHDAY=-4" + midnight},
+		{"0 0 * * 5#2", "FREQ=MONTHLY;BYDAY=2FR" + midnight},
+		{"0 0 * * 5L", "FREQ=MONTHLY;BYDAY=-1FR" + midnight},
+		// 1Wは前月にまたがらないため、1日が土曜日なら3日、日曜日なら2日になり、1〜3日の最初の平日と同じ
+		{"0 0 1W * *", "FREQ=MONTHLY;BYMONTHDAY=1,2,3;BYDAY=MO,TU,WE,TH,FR" + midnight + ";BYSETPOS=1"},
+		{"0 0 LW * *", "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR" + midnight + ";BYSETPOS=-1"},
+		// BYSETPOSは月の中のすべての実行時刻に番号を振るため、1日の実行回数分の位置を指定する
+		{"0 0,12 LW * *", "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYHOUR=0,12;BYMINUTE=0;BYSECOND=0;BYSETPOS=-2,-1"},
+		{"0 9 1W 1,7 ?", "FREQ=MONTHLY;BYMONTH=1,7;BYMONTHDAY=1,2,3;BYDAY=MO,TU,WE,TH,FR;BYHOUR=9;BYMINUTE=0;BYSECOND=0;BYSETPOS=1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := ToRRule(tt.expr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("ToRRule(%q) =\n%s\nwant\n%s", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToRRuleWeekdayDates(t *testing.T) {
+	tests := []struct {
+		expr string
+		// 2025年1〜9月の実行日
+		want []string
+	}{
+		// 2/1・3/1は土曜日、6/1は日曜日
+		{"0 9 1W * *", []string{"01-01", "02-03", "03-03", "04-01", "05-01", "06-02", "07-01", "08-01", "09-01"}},
+		// 5/31は土曜日、8/31は日曜日
+		{"0 9 LW * *", []string{"01-31", "02-28", "03-31", "04-30", "05-30", "06-30", "07-31", "08-29", "09-30"}},
+		// 1日2回の場合も同じ日に2回実行する
+		{"0 9,17 LW 5 *", []string{"05-30", "05-30"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			rule, err := ToRRule(tt.expr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			option, err := rrule.StrToROption(rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			option.Dtstart = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+			rr, err := rrule.NewRRule(*option)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, occurrence := range rr.Between(option.Dtstart, time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC), true) {
+				got = append(got, occurrence.Format("01-02"))
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("occurrence %d = %s, want %s", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestToRRuleErrors(t *testing.T) {
+	tests := []struct {
+		expr      string
+		wantField string
+	}{
+		{"@reboot", ""},
+		{"1 2 3", ""},
+		{"0 0 1 1 * * 2025", ""},
+		{"0 0 30 2 *", ""},
+		{"60 * * * *", "minute"},
+		// 日と曜日のいずれかに一致する日は1つのRRULEで表せない
+		{"0 0 13 * 5", "day-of-month"},
+		{"0 0 L * 5", "day-of-month"},
+		{"0 0 LW * 1-5", "day-of-month"},
+		// 1W・LW以外のWは曜日によって前日にも翌日にも移動する
+		{"0 0 15W * *", "day-of-month"},
+		{"0 0 28W * *", "day-of-month"},
+		{"0 0 1W,15W * *", "day-of-month"},
+		{"* * * LW * *", "day-of-month"},
+		{"0 0 * * L", "day-of-week"},
+		{"0 0 * * 5#6", "day-of-week"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := ToRRule(tt.expr)
+			var cronErr *Error
+			if !errors.As(err, &cronErr) {
+				t.Fatalf("ToRRule(%q) error = %v, want *Error", tt.expr, err)
+			}
+			if cronErr.Field != tt.wantField {
+				t.Errorf("field = %q, want %q (%v)", cronErr.Field, tt.wantField, err)
+			}
+		})
+	}
+}
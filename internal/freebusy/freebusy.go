@@ -0,0 +1,65 @@
+package freebusy
+
+import (
+	"sort"
+	"time"
+
+	"github.com/recurrence-scheduler/internal/models"
+)
+
+// Interval は[Start, End)の時間区間
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// CalendarBusy はカレンダーごとの予定が入っている区間
+type CalendarBusy struct {
+	Calendar *models.Calendar
+	Busy     []Interval
+}
+
+// Busy はインスタンスの期間を[start, end)に切り詰め、重なる区間と隣接する区間をまとめて返す
+// 長さが0のインスタンスは時間を占有しないため含めない
+func Busy(instances []*models.Event, start, end time.Time) []Interval {
+	intervals := make([]Interval, 0, len(instances))
+	for _, instance := range instances {
+		iv := Interval{Start: instance.DTStart, End: instance.DTEnd}
+		if iv.Start.Before(start) {
+			iv.Start = start
+		}
+		if iv.End.After(end) {
+			iv.End = end
+		}
+		if iv.Start.Before(iv.End) {
+			intervals = append(intervals, iv)
+		}
+	}
+	return Merge(intervals)
+}
+
+// Merge は区間を開始時刻順に並べ、重なる区間と隣接する区間を1つにまとめる
+func Merge(intervals []Interval) []Interval {
+	if len(intervals) == 0 {
+		return nil
+	}
+
+	sorted := make([]Interval, len(intervals))
+	copy(sorted, intervals)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Start.Before(sorted[j].Start)
+	})
+
+	merged := []Interval{sorted[0]}
+	for _, iv := range sorted[1:] {
+		last := &merged[len(merged)-1]
+		if iv.Start.After(last.End) {
+			merged = append(merged, iv)
+			continue
+		}
+		if iv.End.After(last.End) {
+			last.End = iv.End
+		}
+	}
+	return merged
+}
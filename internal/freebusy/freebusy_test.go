@@ -0,0 +1,88 @@
+package freebusy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/recurrence-scheduler/internal/models"
+)
+
+// hm は2025-01-06のUTCでhour:minuteの時刻を返す
+func hm(hour, minute int) time.Time {
+	return time.Date(2025, 1, 6, hour, minute, 0, 0, time.UTC)
+}
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name      string
+		intervals []Interval
+		want      []Interval
+	}{
+		{name: "empty", intervals: nil, want: nil},
+		{
+			name:      "overlapping out of order",
+			intervals: []Interval{{hm(10, 0), hm(11, 0)}, {hm(9, 0), hm(10, 30)}},
+			want:      []Interval{{hm(9, 0), hm(11, 0)}},
+		},
+		{
+			name:      "adjacent",
+			intervals: []Interval{{hm(9, 0), hm(10, 0)}, {hm(10, 0), hm(11, 0)}},
+			want:      []Interval{{hm(9, 0), hm(11, 0)}},
+		},
+		{
+			name:      "contained",
+			intervals: []Interval{{hm(9, 0), hm(12, 0)}, {hm(10, 0), hm(11, 0)}},
+			want:      []Interval{{hm(9, 0), hm(12, 0)}},
+		},
+		{
+			name:      "disjoint",
+			intervals: []Interval{{hm(13, 0), hm(14, 0)}, {hm(9, 0), hm(10, 0)}},
+			want:      []Interval{{hm(9, 0), hm(10, 0)}, {hm(13, 0), hm(14, 0)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertIntervals(t, Merge(tt.intervals), tt.want)
+		})
+	}
+}
+
+func TestMergeDoesNotModifyInput(t *testing.T) {
+	intervals := []Interval{{hm(10, 0), hm(11, 0)}, {hm(9, 0), hm(10, 30)}}
+	Merge(intervals)
+	if !intervals[0].Start.Equal(hm(10, 0)) || !intervals[1].End.Equal(hm(10, 30)) {
+		t.Errorf("Merge modified its input: %v", intervals)
+	}
+}
+
+func TestBusy(t *testing.T) {
+	event := func(start, end time.Time) *models.Event {
+		return models.NewEvent("cal", "e", "", start, end, "", "UTC")
+	}
+	instances := []*models.Event{
+		// 期間の開始前から続くインスタンスは開始で切り詰める
+		event(hm(8, 0), hm(9, 30)),
+		event(hm(9, 30), hm(10, 0)),
+		// 長さが0のインスタンスは含めない
+		event(hm(12, 0), hm(12, 0)),
+		event(hm(16, 0), hm(18, 0)),
+		// 期間外のインスタンスは含めない
+		event(hm(18, 0), hm(19, 0)),
+	}
+
+	got := Busy(instances, hm(9, 0), hm(17, 0))
+	assertIntervals(t, got, []Interval{{hm(9, 0), hm(10, 0)}, {hm(16, 0), hm(17, 0)}})
+}
+
+func assertIntervals(t *testing.T, got, want []Interval) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range got {
+		if !got[i].Start.Equal(want[i].Start) || !got[i].End.Equal(want[i].End) {
+			t.Errorf("interval %d = %v-%v, want %v-%v", i, got[i].Start, got[i].End, want[i].Start, want[i].End)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package freebusy
+
+import (
+	"time"
+)
+
+// WorkingHours は1日のうち予定を入れてよい時間帯（カレンダーのタイムゾーンの壁時計時刻）
+// StartとEndは0時からの経過時間で、Daysに含まれる曜日だけを対象にする
+type WorkingHours struct {
+	Start time.Duration
+	End   time.Duration
+	Days  []time.Weekday
+}
+
+// Intervals は[start, end)に含まれる勤務時間帯をlocの壁時計時刻で求めて返す
+// 日ごとにtime.Dateで組み立てるため、DSTの切り替え日も壁時計時刻どおりになる
+func (w WorkingHours) Intervals(loc *time.Location, start, end time.Time) []Interval {
+	days := make(map[time.Weekday]bool, len(w.Days))
+	for _, d := range w.Days {
+		days[d] = true
+	}
+
+	var intervals []Interval
+	// 前日から始めることで、タイムゾーンの差で期間の開始前に始まる勤務時間帯も拾う
+	first := start.In(loc).AddDate(0, 0, -1)
+	for day := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, loc); day.Before(end); day = day.AddDate(0, 0, 1) {
+		if !days[day.Weekday()] {
+			continue
+		}
+		iv := Interval{Start: wallClock(day, w.Start), End: wallClock(day, w.End)}
+		if iv.Start.Before(start) {
+			iv.Start = start
+		}
+		if iv.End.After(end) {
+			iv.End = end
+		}
+		if iv.Start.Before(iv.End) {
+			intervals = append(intervals, iv)
+		}
+	}
+	return intervals
+}
+
+// wallClock はdayの日付で0時からoffset後の壁時計時刻を返す（24:00は翌日の0時）
+func wallClock(day time.Time, offset time.Duration) time.Time {
+	hour := int(offset / time.Hour)
+	minute := int(offset % time.Hour / time.Minute)
+	return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, day.Location())
+}
+
+// Intersect は2つの区間のリストの両方に含まれる区間を返す
+// どちらのリストも開始時刻順で互いに重ならないものとする
+func Intersect(a, b []Interval) []Interval {
+	var result []Interval
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		start, end := later(a[i].Start, b[j].Start), earlier(a[i].End, b[j].End)
+		if start.Before(end) {
+			result = append(result, Interval{Start: start, End: end})
+		}
+		// 先に終わる方を進める
+		if a[i].End.Before(b[j].End) {
+			i++
+		} else {
+			j++
+		}
+	}
+	return result
+}
+
+// Subtract はfromの各区間からbusyと重なる部分を取り除いた区間を返す
+// どちらのリストも開始時刻順で互いに重ならないものとする
+func Subtract(from, busy []Interval) []Interval {
+	var result []Interval
+	j := 0
+	for _, iv := range from {
+		start := iv.Start
+		for j < len(busy) && !busy[j].End.After(start) {
+			j++
+		}
+		for k := j; k < len(busy) && busy[k].Start.Before(iv.End); k++ {
+			if start.Before(busy[k].Start) {
+				result = append(result, Interval{Start: start, End: busy[k].Start})
+			}
+			start = later(start, busy[k].End)
+		}
+		if start.Before(iv.End) {
+			result = append(result, Interval{Start: start, End: iv.End})
+		}
+	}
+	return result
+}
+
+// Pad は各区間の前後をbufferだけ広げ、重なった区間をまとめる
+func Pad(intervals []Interval, buffer time.Duration) []Interval {
+	if buffer <= 0 {
+		return intervals
+	}
+	padded := make([]Interval, len(intervals))
+	for i, iv := range intervals {
+		padded[i] = Interval{Start: iv.Start.Add(-buffer), End: iv.End.Add(buffer)}
+	}
+	return Merge(padded)
+}
+
+// Slots は空いている区間からdurationの長さの枠を早い順に最大n件返す
+// 枠の開始時刻はlocの壁時計時刻でstep単位に揃え、同じ空き区間の中では重ならないように並べる
+func Slots(free []Interval, duration, step time.Duration, loc *time.Location, n int) []Interval {
+	var slots []Interval
+	for _, iv := range free {
+		for start := alignUp(iv.Start, step, loc); !start.Add(duration).After(iv.End); start = alignUp(start.Add(duration), step, loc) {
+			if len(slots) == n {
+				return slots
+			}
+			slots = append(slots, Interval{Start: start, End: start.Add(duration)})
+		}
+	}
+	return slots
+}
+
+// alignUp はt以降で、locの壁時計時刻が0時からstepの倍数になる最初の時刻を返す
+// time.TruncateはUTCの基準で揃えるため、UTCとのオフセットが30分や45分のタイムゾーンではずれる
+func alignUp(t time.Time, step time.Duration, loc *time.Location) time.Time {
+	wall := t.In(loc)
+	sinceMidnight := time.Duration(wall.Hour())*time.Hour + time.Duration(wall.Minute())*time.Minute +
+		time.Duration(wall.Second())*time.Second + time.Duration(wall.Nanosecond())
+	if rem := sinceMidnight % step; rem != 0 {
+		t = t.Add(step - rem)
+	}
+	return t
+}
+
+func later(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func earlier(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,46 @@
+package freebusy
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSlotsAlignToWallClock(t *testing.T) {
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Skip(err)
+	}
+	kathmandu, err := time.LoadLocation("Asia/Kathmandu")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	tests := []struct {
+		name string
+		loc  *time.Location
+		// 空き区間の開始（UTC）
+		start time.Time
+		// 最初の2枠の開始（locの壁時計時刻）
+		want []string
+	}{
+		{name: "UTC", loc: time.UTC, start: time.Date(2025, 1, 6, 3, 7, 0, 0, time.UTC), want: []string{"03:15", "03:45"}},
+		{name: "half-hour offset", loc: kolkata, start: time.Date(2025, 1, 6, 3, 7, 0, 0, time.UTC), want: []string{"08:45", "09:15"}},
+		{name: "45-minute offset", loc: kathmandu, start: time.Date(2025, 1, 6, 3, 7, 0, 0, time.UTC), want: []string{"09:00", "09:30"}},
+		{name: "already aligned", loc: kolkata, start: time.Date(2025, 1, 6, 3, 30, 0, 0, time.UTC), want: []string{"09:00", "09:30"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			free := []Interval{{Start: tt.start, End: tt.start.Add(4 * time.Hour)}}
+			slots := Slots(free, 30*time.Minute, 15*time.Minute, tt.loc, 2)
+			if len(slots) != len(tt.want) {
+				t.Fatalf("got %d slots, want %d", len(slots), len(tt.want))
+			}
+			for i, slot := range slots {
+				if got := slot.Start.In(tt.loc).Format("15:04"); got != tt.want[i] {
+					t.Errorf("slot %d starts at %s, want %s", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package ical
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/recurrence-scheduler/internal/models"
+)
+
+// uidFinder はUIDからイベントを返すEventFinder
+type uidFinder map[string]*models.Event
+
+func (f uidFinder) GetEventByUID(calendarID, uid string) (*models.Event, error) {
+	if event, ok := f[calendarID+"/"+uid]; ok {
+		return event, nil
+	}
+	return nil, sql.ErrNoRows
+}
+
+func TestApply(t *testing.T) {
+	start := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	finder := uidFinder{"cal/parent": {ID: "parent-id"}}
+
+	src := models.NewEvent("", "imported", "notes", start, start.Add(time.Hour), "FREQ=DAILY", "Asia/Tokyo")
+	src.UID = "series"
+	src.ExDates = []time.Time{start.AddDate(0, 0, 1)}
+	src.RelatedTo = "parent"
+
+	master := models.NewEvent("cal", "", "", time.Time{}, time.Time{}, "", "")
+	id := master.ID
+	Apply(master, src, finder)
+	if master.ID != id || master.CalendarID != "cal" {
+		t.Errorf("ID, CalendarID = %s, %s, want %s, cal", master.ID, master.CalendarID, id)
+	}
+	if master.UID != "series" || master.RRule != "FREQ=DAILY" || len(master.ExDates) != 1 || master.Title != "imported" {
+		t.Errorf("master = %+v, want the imported values", master)
+	}
+	if master.RelatedTo != "parent-id" {
+		t.Errorf("RelatedTo = %q, want the ID of the related event", master.RelatedTo)
+	}
+
+	// オーバーライドには繰り返しの値を反映せず、見つからないRELATED-TOはUIDのまま残す
+	src.RecurrenceID = start.AddDate(0, 0, 2)
+	src.RelatedTo = "elsewhere"
+	override := models.NewEvent("cal", "", "", time.Time{}, time.Time{}, "", "")
+	override.UID = "series"
+	Apply(override, src, finder)
+	if override.RRule != "" || override.ExDates != nil {
+		t.Errorf("override has RRULE %q and EXDATE %v, want none", override.RRule, override.ExDates)
+	}
+	if !override.DTStart.Equal(start) || override.Timezone != "Asia/Tokyo" {
+		t.Errorf("override = %+v, want the imported times", override)
+	}
+	if override.RelatedTo != "elsewhere" {
+		t.Errorf("RelatedTo = %q, want the unresolved UID", override.RelatedTo)
+	}
+}
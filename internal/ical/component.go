@@ -0,0 +1,99 @@
+package ical
+
+// ValueType はプロパティ値の型（RFC 5545 3.3）
+type ValueType string
+
+const (
+	TypeText       ValueType = "TEXT"
+	TypeDateTime   ValueType = "DATE-TIME"
+	TypeDate       ValueType = "DATE"
+	TypeRecur      ValueType = "RECUR"
+	TypeUTCOffset  ValueType = "UTC-OFFSET"
+	TypeInteger    ValueType = "INTEGER"
+	TypeDuration   ValueType = "DURATION"
+	TypePeriod     ValueType = "PERIOD"
+	TypeCalAddress ValueType = "CAL-ADDRESS"
+	TypeURI        ValueType = "URI"
+)
+
+// Param はプロパティパラメータ
+type Param struct {
+	Name  string
+	Value string
+}
+
+// Property はコンテンツラインの1プロパティ
+// Valueはエスケープ前の値で、複数値の場合はカンマ区切りで保持する
+type Property struct {
+	Name   string
+	Params []Param
+	Type   ValueType
+	Value  string
+}
+
+// Param は指定した名前のパラメータ値を返す
+func (p *Property) Param(name string) string {
+	for _, param := range p.Params {
+		if param.Name == name {
+			return param.Value
+		}
+	}
+	return ""
+}
+
+// Component はVCALENDAR・VEVENT・VTIMEZONEなどのコンポーネント
+type Component struct {
+	Name       string
+	Properties []*Property
+	Children   []*Component
+}
+
+// NewComponent は新しいコンポーネントを作成
+func NewComponent(name string) *Component {
+	return &Component{Name: name}
+}
+
+// Add はプロパティを追加する
+func (c *Component) Add(name string, typ ValueType, value string, params ...Param) {
+	c.Properties = append(c.Properties, &Property{Name: name, Params: params, Type: typ, Value: value})
+}
+
+// Prop は指定した名前の最初のプロパティを返す
+func (c *Component) Prop(name string) *Property {
+	for _, p := range c.Properties {
+		if p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// Props は指定した名前のすべてのプロパティを返す
+func (c *Component) Props(name string) []*Property {
+	var props []*Property
+	for _, p := range c.Properties {
+		if p.Name == name {
+			props = append(props, p)
+		}
+	}
+	return props
+}
+
+// Value は指定した名前の最初のプロパティの値を返す
+func (c *Component) Value(name string) string {
+	if p := c.Prop(name); p != nil {
+		return p.Value
+	}
+	return ""
+}
+
+// ChildrenNamed は指定した名前の子コンポーネントを返す
+func (c *Component) ChildrenNamed(name string) []*Component {
+	var children []*Component
+	for _, child := range c.Children {
+		if child.Name == name {
+			children = append(children, child)
+		}
+	}
+	return children
+}
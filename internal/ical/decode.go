@@ -0,0 +1,195 @@
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// defaultTypes はVALUEパラメータがない場合のプロパティの既定の値型
+var defaultTypes = map[string]ValueType{
+	"DTSTART":          TypeDateTime,
+	"DTEND":            TypeDateTime,
+	"DTSTAMP":          TypeDateTime,
+	"DUE":              TypeDateTime,
+	"CREATED":          TypeDateTime,
+	"LAST-MODIFIED":    TypeDateTime,
+	"COMPLETED":        TypeDateTime,
+	"RECURRENCE-ID":    TypeDateTime,
+	"EXDATE":           TypeDateTime,
+	"RDATE":            TypeDateTime,
+	"RRULE":            TypeRecur,
+	"EXRULE":           TypeRecur,
+	"TZOFFSETFROM":     TypeUTCOffset,
+	"TZOFFSETTO":       TypeUTCOffset,
+	"DURATION":         TypeDuration,
+	"TRIGGER":          TypeDuration,
+	"FREEBUSY":         TypePeriod,
+	"ATTENDEE":         TypeCalAddress,
+	"ORGANIZER":        TypeCalAddress,
+	"URL":              TypeURI,
+	"TZURL":            TypeURI,
+	"SEQUENCE":         TypeInteger,
+	"PRIORITY":         TypeInteger,
+	"REPEAT":           TypeInteger,
+	"PERCENT-COMPLETE": TypeInteger,
+}
+
+// PropertyType はプロパティ名とVALUEパラメータから値型を決める
+func PropertyType(name, valueParam string) ValueType {
+	if valueParam != "" {
+		return ValueType(strings.ToUpper(valueParam))
+	}
+	if t, ok := defaultTypes[name]; ok {
+		return t
+	}
+	return TypeText
+}
+
+// Parse はiCalendar形式のテキストを解析し、最初のトップレベルコンポーネントを返す
+func Parse(r io.Reader) (*Component, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var stack []*Component
+	var root *Component
+	for i, line := range lines {
+		if line == "" {
+			continue
+		}
+
+		prop, err := parseContentLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		switch prop.Name {
+		case "BEGIN":
+			c := NewComponent(strings.ToUpper(prop.Value))
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.Children = append(parent.Children, c)
+			} else if root != nil {
+				// 2つ目以降のトップレベルコンポーネントは無視する
+				return root, nil
+			}
+			stack = append(stack, c)
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1].Name != strings.ToUpper(prop.Value) {
+				return nil, fmt.Errorf("line %d: unexpected END:%s", i+1, prop.Value)
+			}
+			c := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if len(stack) == 0 {
+				root = c
+			}
+		default:
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("line %d: property %s outside of a component", i+1, prop.Name)
+			}
+			c := stack[len(stack)-1]
+			c.Properties = append(c.Properties, prop)
+		}
+	}
+
+	if len(stack) > 0 {
+		return nil, fmt.Errorf("missing END:%s", stack[len(stack)-1].Name)
+	}
+	if root == nil {
+		return nil, errors.New("no component found")
+	}
+	return root, nil
+}
+
+// unfold は折り返された行を結合する（RFC 5545 3.1）
+func unfold(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if len(line) > 0 && (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, scanner.Err()
+}
+
+// parseContentLine は1行を名前・パラメータ・値に分解する
+func parseContentLine(line string) (*Property, error) {
+	i := strings.IndexAny(line, ";:")
+	if i <= 0 {
+		return nil, fmt.Errorf("malformed content line %q", line)
+	}
+
+	prop := &Property{Name: strings.ToUpper(line[:i])}
+	rest := line[i:]
+
+	for len(rest) > 0 && rest[0] == ';' {
+		rest = rest[1:]
+		eq := strings.IndexByte(rest, '=')
+		if eq <= 0 {
+			return nil, fmt.Errorf("malformed parameter in %q", line)
+		}
+		name := strings.ToUpper(rest[:eq])
+		rest = rest[eq+1:]
+
+		// パラメータ値はダブルクォートで囲まれている場合、区切り文字を含みうる
+		var value strings.Builder
+		for len(rest) > 0 && rest[0] != ';' && rest[0] != ':' {
+			if rest[0] == '"' {
+				end := strings.IndexByte(rest[1:], '"')
+				if end < 0 {
+					return nil, fmt.Errorf("unterminated quoted parameter in %q", line)
+				}
+				value.WriteString(rest[1 : end+1])
+				rest = rest[end+2:]
+				continue
+			}
+			value.WriteByte(rest[0])
+			rest = rest[1:]
+		}
+		prop.Params = append(prop.Params, Param{Name: name, Value: value.String()})
+	}
+
+	if len(rest) == 0 || rest[0] != ':' {
+		return nil, fmt.Errorf("missing value in %q", line)
+	}
+
+	prop.Type = PropertyType(prop.Name, prop.Param("VALUE"))
+	prop.Value = rest[1:]
+	if prop.Type == TypeText {
+		prop.Value = UnescapeText(prop.Value)
+	}
+	return prop, nil
+}
+
+// UnescapeText はEscapeTextの逆変換
+func UnescapeText(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n', 'N':
+			b.WriteByte('\n')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,94 @@
+package ical
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxLineOctets はCRLFを除く1行の最大オクテット数（RFC 5545 3.1）
+const maxLineOctets = 75
+
+// Encode はコンポーネントをiCalendar形式のテキストで書き出す
+func Encode(w io.Writer, c *Component) error {
+	var buf bytes.Buffer
+	encodeComponent(&buf, c)
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// Marshal はコンポーネントをiCalendar形式のバイト列に変換する
+func Marshal(c *Component) []byte {
+	var buf bytes.Buffer
+	encodeComponent(&buf, c)
+	return buf.Bytes()
+}
+
+func encodeComponent(buf *bytes.Buffer, c *Component) {
+	writeLine(buf, "BEGIN:"+c.Name)
+	for _, p := range c.Properties {
+		writeLine(buf, contentLine(p))
+	}
+	for _, child := range c.Children {
+		encodeComponent(buf, child)
+	}
+	writeLine(buf, "END:"+c.Name)
+}
+
+// contentLine はプロパティを折り返し前の1行に変換する
+func contentLine(p *Property) string {
+	var b strings.Builder
+	b.WriteString(p.Name)
+	for _, param := range p.Params {
+		b.WriteByte(';')
+		b.WriteString(param.Name)
+		b.WriteByte('=')
+		b.WriteString(quoteParam(param.Value))
+	}
+	b.WriteByte(':')
+	if p.Type == TypeText {
+		b.WriteString(EscapeText(p.Value))
+	} else {
+		b.WriteString(p.Value)
+	}
+	return b.String()
+}
+
+// writeLine は75オクテットを超える行をUTF-8の文字境界で折り返して書き出す
+func writeLine(buf *bytes.Buffer, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		buf.WriteString(line[:cut])
+		buf.WriteString("\r\n ")
+		line = line[cut:]
+		// 継続行は先頭の空白を含めて75オクテットに収める
+		limit = maxLineOctets - 1
+	}
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
+
+// EscapeText はTEXT型の値をエスケープする（RFC 5545 3.3.11）
+func EscapeText(s string) string {
+	r := strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	)
+	return r.Replace(s)
+}
+
+// quoteParam は区切り文字を含むパラメータ値をダブルクォートで囲む
+func quoteParam(v string) string {
+	if strings.ContainsAny(v, ";:,") {
+		return `"` + strings.ReplaceAll(v, `"`, "") + `"`
+	}
+	return v
+}
@@ -0,0 +1,36 @@
+package ical
+
+import (
+	"strings"
+	"time"
+
+	"github.com/recurrence-scheduler/internal/freebusy"
+)
+
+// FreeBusyCalendar は空き時間の問い合わせ結果を、カレンダーごとのVFREEBUSYを含むVCALENDARに変換する（RFC 5545 3.6.4）
+// イベントの内容は含めず、予定が入っている区間だけをUTCのFREEBUSYプロパティで表す
+func FreeBusyCalendar(start, end time.Time, calendars []freebusy.CalendarBusy) *Component {
+	vcal := newVCalendar()
+	vcal.Add("METHOD", TypeText, "PUBLISH")
+
+	now := time.Now().UTC().Format(utcLayout)
+	for _, cb := range calendars {
+		vfreebusy := NewComponent("VFREEBUSY")
+		vfreebusy.Add("UID", TypeText, cb.Calendar.ID+"-freebusy-"+start.UTC().Format(utcLayout))
+		vfreebusy.Add("DTSTAMP", TypeDateTime, now)
+		vfreebusy.Add("DTSTART", TypeDateTime, start.UTC().Format(utcLayout))
+		vfreebusy.Add("DTEND", TypeDateTime, end.UTC().Format(utcLayout))
+		vfreebusy.Add("COMMENT", TypeText, cb.Calendar.Name)
+
+		if len(cb.Busy) > 0 {
+			periods := make([]string, len(cb.Busy))
+			for i, iv := range cb.Busy {
+				periods[i] = iv.Start.UTC().Format(utcLayout) + "/" + iv.End.UTC().Format(utcLayout)
+			}
+			vfreebusy.Add("FREEBUSY", TypePeriod, strings.Join(periods, ","), Param{Name: "FBTYPE", Value: "BUSY"})
+		}
+
+		vcal.Children = append(vcal.Children, vfreebusy)
+	}
+	return vcal
+}
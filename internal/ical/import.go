@@ -0,0 +1,514 @@
+package ical
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/teambition/rrule-go"
+
+	"github.com/recurrence-scheduler/internal/models"
+)
+
+const dateLayout = "20060102"
+
+// windowsZones はOutlookなどが出力するWindowsのタイムゾーン名とIANA名の対応（主要なもののみ）
+var windowsZones = map[string]string{
+	"UTC":                            "UTC",
+	"GMT Standard Time":              "Europe/London",
+	"W. Europe Standard Time":        "Europe/Berlin",
+	"Romance Standard Time":          "Europe/Paris",
+	"Central Europe Standard Time":   "Europe/Budapest",
+	"Central European Standard Time": "Europe/Warsaw",
+	"E. Europe Standard Time":        "Europe/Chisinau",
+	"FLE Standard Time":              "Europe/Kiev",
+	"Russian Standard Time":          "Europe/Moscow",
+	"Eastern Standard Time":          "America/New_York",
+	"Central Standard Time":          "America/Chicago",
+	"Mountain Standard Time":         "America/Denver",
+	"Pacific Standard Time":          "America/Los_Angeles",
+	"Tokyo Standard Time":            "Asia/Tokyo",
+	"Korea Standard Time":            "Asia/Seoul",
+	"China Standard Time":            "Asia/Shanghai",
+	"Singapore Standard Time":        "Asia/Singapore",
+	"India Standard Time":            "Asia/Kolkata",
+	"AUS Eastern Standard Time":      "Australia/Sydney",
+}
+
+// zone はTZIDを解決した結果
+// IANAのタイムゾーンに対応付けられない場合は、VTIMEZONEの定義からオフセットを計算する
+type zone struct {
+	loc      *time.Location
+	vtz      *Component
+	name     string // イベントに保存するタイムゾーン名
+	unmapped bool   // IANAのタイムゾーンに対応付けられなかった（nameはUTC）
+}
+
+// resolver はVCALENDAR内のVTIMEZONEを使ってTZIDを解決する
+type resolver struct {
+	vtimezones map[string]*Component
+	defaultTZ  string
+	cache      map[string]*zone
+}
+
+// newResolver はVCALENDARからresolverを作成する
+func newResolver(vcal *Component, defaultTZ string) *resolver {
+	r := &resolver{
+		vtimezones: make(map[string]*Component),
+		defaultTZ:  defaultTZ,
+		cache:      make(map[string]*zone),
+	}
+	for _, vtz := range vcal.ChildrenNamed("VTIMEZONE") {
+		r.vtimezones[vtz.Value("TZID")] = vtz
+	}
+	return r
+}
+
+// lookup はTZIDに対応するzoneを返す
+func (r *resolver) lookup(tzid string) *zone {
+	if z, ok := r.cache[tzid]; ok {
+		return z
+	}
+
+	vtz := r.vtimezones[tzid]
+	candidates := []string{tzid}
+	if vtz != nil {
+		candidates = append(candidates, vtz.Value("X-LIC-LOCATION"))
+	}
+	if iana, ok := windowsZones[tzid]; ok {
+		candidates = append(candidates, iana)
+	}
+	// "/mozilla.org/20050126_1/Europe/Berlin" のような接頭辞付きのTZID
+	if segments := strings.Split(tzid, "/"); len(segments) > 2 {
+		candidates = append(candidates,
+			strings.Join(segments[len(segments)-2:], "/"),
+			strings.Join(segments[len(segments)-3:], "/"))
+	}
+
+	z := &zone{loc: time.UTC, vtz: vtz, name: "UTC", unmapped: true}
+	for _, name := range candidates {
+		if name == "" || name == "Local" {
+			continue
+		}
+		if loc, err := time.LoadLocation(name); err == nil {
+			z = &zone{loc: loc, name: loc.String()}
+			break
+		}
+	}
+
+	r.cache[tzid] = z
+	return z
+}
+
+// parsedTimes は日時プロパティの解析結果
+type parsedTimes struct {
+	times  []time.Time
+	tzName string
+	isDate bool
+	// unmappedTZID はIANAのタイムゾーンに対応付けられず、VTIMEZONEの定義で変換したTZID
+	unmappedTZID string
+}
+
+// parse は日時プロパティ（複数値を含む）を解析する
+// DATE型の値は既定のタイムゾーンの0時として扱う
+// IANAのタイムゾーンに対応付けられず、VTIMEZONEもないTZIDの日時はエラーにする
+func (r *resolver) parse(prop *Property) (*parsedTimes, error) {
+	result := &parsedTimes{tzName: r.defaultTZ}
+	tzid := prop.Param("TZID")
+
+	var z *zone
+	if tzid != "" {
+		z = r.lookup(tzid)
+		result.tzName = z.name
+	}
+
+	for _, v := range strings.Split(prop.Value, ",") {
+		// PERIOD型のRDATEは開始時刻のみ使う
+		if i := strings.IndexByte(v, '/'); i >= 0 {
+			v = v[:i]
+		}
+
+		var t time.Time
+		var err error
+		switch {
+		case prop.Type == TypeDate || len(v) == len(dateLayout):
+			result.isDate = true
+			t, err = time.ParseInLocation(dateLayout, v, location(r.defaultTZ))
+		case strings.HasSuffix(v, "Z"):
+			t, err = time.Parse(utcLayout, v)
+			if tzid == "" {
+				result.tzName = "UTC"
+			}
+		case z != nil && z.unmapped && z.vtz == nil:
+			return nil, fmt.Errorf("unknown TZID %q in %s", tzid, prop.Name)
+		case z != nil && z.vtz != nil:
+			result.unmappedTZID = tzid
+			var wall time.Time
+			wall, err = time.Parse(localLayout, v)
+			if err == nil {
+				t = wall.Add(-time.Duration(customOffset(z.vtz, wall)) * time.Second)
+			}
+		case z != nil:
+			t, err = time.ParseInLocation(localLayout, v, z.loc)
+		default:
+			// フローティング時刻は既定のタイムゾーンで解釈する
+			t, err = time.ParseInLocation(localLayout, v, location(r.defaultTZ))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value %q", prop.Name, v)
+		}
+		result.times = append(result.times, t)
+	}
+
+	return result, nil
+}
+
+// customOffset はVTIMEZONEの定義から、壁時計時刻wall（UTCとして表現）におけるUTCオフセット（秒）を求める
+// wall以前で最も新しく開始したSTANDARD/DAYLIGHTのTZOFFSETTOを使う
+func customOffset(vtz *Component, wall time.Time) int {
+	var latest time.Time
+	offset, found := 0, false
+	var earliest time.Time
+	earliestFrom := 0
+
+	for _, obs := range vtz.Children {
+		start, err := time.Parse(localLayout, obs.Value("DTSTART"))
+		if err != nil {
+			continue
+		}
+		to, errTo := parseOffset(obs.Value("TZOFFSETTO"))
+		from, errFrom := parseOffset(obs.Value("TZOFFSETFROM"))
+		if errTo != nil || errFrom != nil {
+			continue
+		}
+
+		if earliest.IsZero() || start.Before(earliest) {
+			earliest, earliestFrom = start, from
+		}
+
+		onset := start
+		if rule := obs.Value("RRULE"); rule != "" {
+			if r, err := rrule.StrToRRule(rule); err == nil {
+				r.DTStart(start)
+				onset = r.Before(wall, true)
+			}
+		}
+		if onset.IsZero() || onset.After(wall) {
+			continue
+		}
+		if !found || onset.After(latest) {
+			latest, offset, found = onset, to, true
+		}
+	}
+
+	if !found {
+		return earliestFrom
+	}
+	return offset
+}
+
+// parseOffset は±hhmm[ss]形式のオフセットを秒に変換する
+func parseOffset(s string) (int, error) {
+	if len(s) != 5 && len(s) != 7 {
+		return 0, fmt.Errorf("invalid UTC offset %q", s)
+	}
+	sign := 1
+	switch s[0] {
+	case '-':
+		sign = -1
+	case '+':
+	default:
+		return 0, fmt.Errorf("invalid UTC offset %q", s)
+	}
+
+	var parts [3]int
+	for i := 0; i*2+1 < len(s); i++ {
+		v, err := strconv.Atoi(s[i*2+1 : i*2+3])
+		if err != nil {
+			return 0, fmt.Errorf("invalid UTC offset %q", s)
+		}
+		parts[i] = v
+	}
+	return sign * (parts[0]*3600 + parts[1]*60 + parts[2]), nil
+}
+
+var durationPattern = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// ParseDuration はRFC 5545のDURATION値（例: PT1H30M, P1D, -P2W）を解析する
+func ParseDuration(s string) (time.Duration, error) {
+	m := durationPattern.FindStringSubmatch(s)
+	if m == nil || s == "P" || strings.HasSuffix(s, "T") {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var d time.Duration
+	for i, unit := range units {
+		if m[i+2] == "" {
+			continue
+		}
+		n, _ := strconv.Atoi(m[i+2])
+		d += time.Duration(n) * unit
+	}
+	if m[1] == "-" {
+		d = -d
+	}
+	return d, nil
+}
+
+// FormatDuration はtime.DurationをRFC 5545のDURATION値に変換する
+func FormatDuration(d time.Duration) string {
+	var b strings.Builder
+	if d < 0 {
+		b.WriteByte('-')
+		d = -d
+	}
+	b.WriteByte('P')
+
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+	if days > 0 {
+		fmt.Fprintf(&b, "%dD", days)
+	}
+	if d > 0 || days == 0 {
+		b.WriteByte('T')
+		h, m, s := d/time.Hour, d%time.Hour/time.Minute, d%time.Minute/time.Second
+		if h > 0 {
+			fmt.Fprintf(&b, "%dH", h)
+		}
+		if m > 0 {
+			fmt.Fprintf(&b, "%dM", m)
+		}
+		if s > 0 || (h == 0 && m == 0) {
+			fmt.Fprintf(&b, "%dS", s)
+		}
+	}
+	return b.String()
+}
+
+// normalizeUntil はRRULEのUNTILをUTCの日時形式に揃える
+// DATE型やフローティングのUNTILはイベントのタイムゾーンで解釈する
+func normalizeUntil(rule string, loc *time.Location) string {
+	parts := strings.Split(rule, ";")
+	for i, part := range parts {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || strings.ToUpper(name) != "UNTIL" {
+			continue
+		}
+
+		var until time.Time
+		var err error
+		switch {
+		case len(value) == len(dateLayout):
+			// DATE型のUNTILはその日の終わりまでを含む
+			until, err = time.ParseInLocation(dateLayout, value, loc)
+			until = until.AddDate(0, 0, 1).Add(-time.Second)
+		case strings.HasSuffix(value, "Z"):
+			continue
+		default:
+			until, err = time.ParseInLocation(localLayout, value, loc)
+		}
+		if err == nil {
+			parts[i] = "UNTIL=" + until.UTC().Format(utcLayout)
+		}
+	}
+	return strings.Join(parts, ";")
+}
+
+// EventFromComponent はVEVENTをイベントに変換する
+// CalendarIDやID、オーバーライドの場合のRecurringEventIDは呼び出し側で設定する
+// 変換はできたが元の内容を完全には表せない場合は、その内容をwarningで返す
+func (r *resolver) EventFromComponent(vevent *Component) (event *models.Event, warning string, err error) {
+	uid := vevent.Value("UID")
+	if uid == "" {
+		return nil, "", errors.New("UID is required")
+	}
+
+	dtStartProp := vevent.Prop("DTSTART")
+	if dtStartProp == nil {
+		return nil, "", errors.New("DTSTART is required")
+	}
+	start, err := r.parse(dtStartProp)
+	if err != nil {
+		return nil, "", err
+	}
+
+	event = &models.Event{
+		UID:         uid,
+		Title:       vevent.Value("SUMMARY"),
+		Description: vevent.Value("DESCRIPTION"),
+		DTStart:     start.times[0],
+		Timezone:    start.tzName,
+		AllDay:      start.isDate,
+		RelatedTo:   vevent.Value("RELATED-TO"),
+	}
+	if start.unmappedTZID != "" {
+		// 時刻はVTIMEZONEの定義で正しく変換できるが、繰り返しはUTCで展開することになる
+		warning = fmt.Sprintf("TZID %q has no IANA time zone equivalent; stored as UTC, so recurrences do not follow its daylight saving rules", start.unmappedTZID)
+	}
+
+	switch {
+	case vevent.Prop("DTEND") != nil:
+		end, err := r.parse(vevent.Prop("DTEND"))
+		if err != nil {
+			return nil, "", err
+		}
+		event.DTEnd = end.times[0]
+	case vevent.Prop("DURATION") != nil:
+		d, err := ParseDuration(vevent.Value("DURATION"))
+		if err != nil {
+			return nil, "", err
+		}
+		event.DTEnd = event.DTStart.Add(d)
+	case start.isDate:
+		// 終日イベントの既定の長さは1日（RFC 5545 3.6.1）
+		event.DTEnd = event.DTStart.AddDate(0, 0, 1)
+	default:
+		event.DTEnd = event.DTStart
+	}
+	if event.DTEnd.Before(event.DTStart) {
+		return nil, "", errors.New("DTEND must not be before DTSTART")
+	}
+
+	if rule := vevent.Value("RRULE"); rule != "" {
+		event.RRule = normalizeUntil(rule, location(event.Timezone))
+	}
+
+	for _, prop := range vevent.Props("EXDATE") {
+		exDates, err := r.parse(prop)
+		if err != nil {
+			return nil, "", err
+		}
+		event.ExDates = append(event.ExDates, exDates.times...)
+	}
+	for _, prop := range vevent.Props("RDATE") {
+		rDates, err := r.parse(prop)
+		if err != nil {
+			return nil, "", err
+		}
+		event.RDates = append(event.RDates, rDates.times...)
+	}
+
+	if prop := vevent.Prop("RECURRENCE-ID"); prop != nil {
+		recurrenceID, err := r.parse(prop)
+		if err != nil {
+			return nil, "", err
+		}
+		event.RecurrenceID = recurrenceID.times[0]
+	}
+
+	for _, valarm := range vevent.ChildrenNamed("VALARM") {
+		// 同じ内容のVALARMは1つにまとめる
+		if reminder, ok := r.reminderFromComponent(uid, valarm); ok && event.FindReminder(reminder.ID) == nil {
+			// 書き出し時に補ったタイトルは説明として取り込まない
+			if reminder.Description == event.Title {
+				reminder.Description = ""
+			}
+			event.Reminders = append(event.Reminders, reminder)
+		}
+	}
+
+	return event, warning, nil
+}
+
+// reminderFromComponent はVALARMをリマインダーに変換する
+// 対応していないACTION（AUDIO、PROCEDUREなど）や解釈できないTRIGGERのVALARMは取り込まない（falseを返す）
+// UIDのないVALARMは内容から決まるIDにして、取り込み直しても同じリマインダーとして扱う
+func (r *resolver) reminderFromComponent(eventUID string, valarm *Component) (models.Reminder, bool) {
+	reminder := models.Reminder{
+		Action:      models.ReminderAction(strings.ToUpper(valarm.Value("ACTION"))),
+		Related:     models.RelatedStart,
+		Description: valarm.Value("DESCRIPTION"),
+	}
+	if reminder.Action != models.ReminderDisplay && reminder.Action != models.ReminderEmail {
+		return reminder, false
+	}
+
+	trigger := valarm.Prop("TRIGGER")
+	if trigger == nil {
+		return reminder, false
+	}
+	if trigger.Type == TypeDateTime {
+		at, err := r.parse(trigger)
+		if err != nil {
+			return reminder, false
+		}
+		reminder.At = at.times[0]
+		reminder.Related = ""
+	} else {
+		offset, err := ParseDuration(trigger.Value)
+		if err != nil {
+			return reminder, false
+		}
+		reminder.Offset = offset
+		if strings.EqualFold(trigger.Param("RELATED"), string(models.RelatedEnd)) {
+			reminder.Related = models.RelatedEnd
+		}
+	}
+
+	for _, attendee := range valarm.Props("ATTENDEE") {
+		if v := attendee.Value; len(v) > len("mailto:") && strings.EqualFold(v[:len("mailto:")], "mailto:") {
+			reminder.Recipients = append(reminder.Recipients, v[len("mailto:"):])
+		}
+	}
+	if reminder.Action == models.ReminderEmail && len(reminder.Recipients) == 0 {
+		return reminder, false
+	}
+
+	reminder.ID = valarm.Value("UID")
+	if reminder.ID == "" {
+		reminder.ID = uuid.NewSHA1(uuid.NameSpaceURL, []byte(eventUID+"/"+string(reminder.Action)+"/"+trigger.Value+"/"+trigger.Param("RELATED"))).String()
+	}
+	return reminder, true
+}
+
+// Events はVCALENDAR内のすべてのVEVENTを変換する
+// 変換に失敗したVEVENTはエラーとして、変換時の注意（EventFromComponentのwarning）は警告として同じ位置に返す
+func Events(vcal *Component, defaultTZ string) ([]*Component, []*models.Event, []string, []error) {
+	r := newResolver(vcal, defaultTZ)
+
+	vevents := vcal.ChildrenNamed("VEVENT")
+	events := make([]*models.Event, len(vevents))
+	warnings := make([]string, len(vevents))
+	errs := make([]error, len(vevents))
+	for i, vevent := range vevents {
+		events[i], warnings[i], errs[i] = r.EventFromComponent(vevent)
+	}
+	return vevents, events, warnings, errs
+}
+
+// EventFinder はカレンダー内のイベントをUIDで探す（storage.Storageが満たす）
+type EventFinder interface {
+	GetEventByUID(calendarID, uid string) (*models.Event, error)
+}
+
+// Apply は取り込んだイベントsrcの内容を、保存するイベントdstに反映する
+// IDや作成日時などdst側で決まる値は変更しない。srcがオーバーライド（RECURRENCE-IDあり）の場合、
+// UIDと繰り返しの値（RRULE・EXDATE・RDATE）は親イベントのものを使うため反映しない
+// RELATED-TOのUIDは、dstのカレンダーに対応するイベントがあればそのIDに置き換え、なければUIDのまま保存する
+func Apply(dst, src *models.Event, finder EventFinder) {
+	if src.RecurrenceID.IsZero() {
+		dst.UID = src.UID
+		dst.RRule = src.RRule
+		dst.ExDates = src.ExDates
+		dst.RDates = src.RDates
+	}
+	dst.Title = src.Title
+	dst.Description = src.Description
+	dst.DTStart = src.DTStart
+	dst.DTEnd = src.DTEnd
+	dst.Timezone = src.Timezone
+	dst.AllDay = src.AllDay
+	dst.Reminders = src.Reminders
+
+	dst.RelatedTo = src.RelatedTo
+	if src.RelatedTo != "" {
+		if related, err := finder.GetEventByUID(dst.CalendarID, src.RelatedTo); err == nil {
+			dst.RelatedTo = related.ID
+		}
+	}
+}
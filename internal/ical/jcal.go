@@ -0,0 +1,275 @@
+package ical
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// JCalContentType はjCal（RFC 7265）のContent-Type
+const JCalContentType = "application/calendar+json"
+
+// recurIntegerParts はRECUR値のうちjCalで数値として表す要素（RFC 7265 3.6.10）
+var recurIntegerParts = map[string]bool{
+	"COUNT": true, "INTERVAL": true, "BYSECOND": true, "BYMINUTE": true, "BYHOUR": true,
+	"BYMONTHDAY": true, "BYYEARDAY": true, "BYWEEKNO": true, "BYMONTH": true, "BYSETPOS": true,
+}
+
+// MarshalJCal はコンポーネントをjCal形式のJSONに変換する
+func MarshalJCal(c *Component) ([]byte, error) {
+	return json.Marshal(jcalComponent(c))
+}
+
+// jcalComponent はコンポーネントを [name, properties, components] の配列にする
+func jcalComponent(c *Component) []any {
+	props := make([]any, 0, len(c.Properties))
+	for _, p := range c.Properties {
+		props = append(props, jcalProperty(p))
+	}
+	children := make([]any, 0, len(c.Children))
+	for _, child := range c.Children {
+		children = append(children, jcalComponent(child))
+	}
+	return []any{strings.ToLower(c.Name), props, children}
+}
+
+// jcalProperty はプロパティを [name, parameters, type, value...] の配列にする
+// 値型は配列の要素で表すため、VALUEパラメータは含めない
+func jcalProperty(p *Property) []any {
+	params := make(map[string]any)
+	for _, param := range p.Params {
+		if param.Name != "VALUE" {
+			params[strings.ToLower(param.Name)] = param.Value
+		}
+	}
+
+	prop := []any{strings.ToLower(p.Name), params, strings.ToLower(string(p.Type))}
+	return append(prop, jcalValues(p)...)
+}
+
+// jcalValues はプロパティの値をjCalの値に変換する
+func jcalValues(p *Property) []any {
+	switch p.Type {
+	case TypeText, TypeDuration, TypeURI, TypeCalAddress:
+		return []any{p.Value}
+	case TypeRecur:
+		return []any{jcalRecur(p.Value)}
+	}
+
+	var values []any
+	for _, v := range strings.Split(p.Value, ",") {
+		switch p.Type {
+		case TypeDateTime:
+			values = append(values, extendedDateTime(v))
+		case TypeDate:
+			values = append(values, extendedDate(v))
+		case TypePeriod:
+			start, end, _ := strings.Cut(v, "/")
+			if strings.HasPrefix(end, "P") || strings.HasPrefix(end, "+P") || strings.HasPrefix(end, "-P") {
+				values = append(values, []any{extendedDateTime(start), end})
+			} else {
+				values = append(values, []any{extendedDateTime(start), extendedDateTime(end)})
+			}
+		case TypeUTCOffset:
+			values = append(values, extendedOffset(v))
+		case TypeInteger:
+			if n, err := strconv.Atoi(v); err == nil {
+				values = append(values, n)
+			} else {
+				values = append(values, v)
+			}
+		default:
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// jcalRecur はRECUR値をオブジェクトにする（RFC 7265 3.6.10）
+// 複数の値を持つ要素は配列、1つの値は配列にしない
+func jcalRecur(v string) map[string]any {
+	recur := make(map[string]any)
+	for _, part := range strings.Split(v, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok {
+			continue
+		}
+		name = strings.ToUpper(name)
+
+		var values []any
+		for _, item := range strings.Split(value, ",") {
+			switch {
+			case recurIntegerParts[name]:
+				if n, err := strconv.Atoi(item); err == nil {
+					values = append(values, n)
+					continue
+				}
+				values = append(values, item)
+			case name == "UNTIL":
+				values = append(values, extendedDateTime(item))
+			default:
+				values = append(values, item)
+			}
+		}
+
+		if len(values) == 1 {
+			recur[strings.ToLower(name)] = values[0]
+		} else {
+			recur[strings.ToLower(name)] = values
+		}
+	}
+	return recur
+}
+
+// ParseJCal はjCal形式のJSONを解析してコンポーネントに変換する
+// 変換後はParseの結果と同じ形になるため、iCalendarと同じ処理で扱える
+func ParseJCal(data []byte) (*Component, error) {
+	var raw []any
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("invalid jCal: %w", err)
+	}
+	return parseJCalComponent(raw)
+}
+
+func parseJCalComponent(raw []any) (*Component, error) {
+	if len(raw) != 3 {
+		return nil, errors.New("invalid jCal: a component must have 3 elements")
+	}
+	name, ok := raw[0].(string)
+	props, okProps := raw[1].([]any)
+	children, okChildren := raw[2].([]any)
+	if !ok || !okProps || !okChildren {
+		return nil, errors.New("invalid jCal component")
+	}
+
+	c := NewComponent(strings.ToUpper(name))
+	for _, p := range props {
+		rawProp, ok := p.([]any)
+		if !ok {
+			return nil, fmt.Errorf("invalid jCal property in %s", c.Name)
+		}
+		prop, err := parseJCalProperty(rawProp)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", c.Name, err)
+		}
+		c.Properties = append(c.Properties, prop)
+	}
+	for _, child := range children {
+		rawChild, ok := child.([]any)
+		if !ok {
+			return nil, fmt.Errorf("invalid jCal component in %s", c.Name)
+		}
+		childComponent, err := parseJCalComponent(rawChild)
+		if err != nil {
+			return nil, err
+		}
+		c.Children = append(c.Children, childComponent)
+	}
+	return c, nil
+}
+
+func parseJCalProperty(raw []any) (*Property, error) {
+	if len(raw) < 4 {
+		return nil, errors.New("invalid jCal property: too few elements")
+	}
+	name, okName := raw[0].(string)
+	params, okParams := raw[1].(map[string]any)
+	typ, okType := raw[2].(string)
+	if !okName || !okParams || !okType {
+		return nil, errors.New("invalid jCal property")
+	}
+
+	prop := &Property{Name: strings.ToUpper(name), Type: ValueType(strings.ToUpper(typ))}
+	if typ == "unknown" {
+		prop.Type = TypeText
+	}
+
+	for paramName, v := range params {
+		prop.Params = append(prop.Params, Param{Name: strings.ToUpper(paramName), Value: fmt.Sprint(v)})
+	}
+	// 既定と異なる値型はテキスト形式に戻したときのためVALUEパラメータで表す
+	if typ != "unknown" && prop.Type != PropertyType(prop.Name, "") {
+		prop.Params = append(prop.Params, Param{Name: "VALUE", Value: string(prop.Type)})
+	}
+
+	values := make([]string, 0, len(raw)-3)
+	for _, v := range raw[3:] {
+		s, err := textValue(prop.Type, v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", prop.Name, err)
+		}
+		values = append(values, s)
+	}
+	prop.Value = strings.Join(values, ",")
+	return prop, nil
+}
+
+// textValue はjCalの値をiCalendarのテキスト形式に戻す
+func textValue(typ ValueType, v any) (string, error) {
+	switch typ {
+	case TypeRecur:
+		recur, ok := v.(map[string]any)
+		if !ok {
+			return "", errors.New("RECUR value must be an object")
+		}
+		return textRecur(recur), nil
+	case TypePeriod:
+		period, ok := v.([]any)
+		if !ok || len(period) != 2 {
+			return "", errors.New("PERIOD value must be a 2-element array")
+		}
+		start, end := fmt.Sprint(period[0]), fmt.Sprint(period[1])
+		if !strings.Contains(end, "P") {
+			end = textDateTime(end)
+		}
+		return textDateTime(start) + "/" + end, nil
+	}
+
+	s, ok := v.(string)
+	if !ok {
+		if n, isNumber := v.(json.Number); isNumber {
+			return n.String(), nil
+		}
+		return "", fmt.Errorf("unexpected %s value %v", typ, v)
+	}
+	return basicValue(typ, s), nil
+}
+
+// textRecur はRECURオブジェクトをFREQから始まるRECUR値に戻す
+func textRecur(recur map[string]any) string {
+	var names []string
+	for name := range recur {
+		if strings.ToLower(name) != "freq" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	parts := []string{"FREQ=" + strings.ToUpper(fmt.Sprint(recur["freq"]))}
+	for _, name := range names {
+		var items []string
+		switch v := recur[name].(type) {
+		case []any:
+			for _, item := range v {
+				items = append(items, fmt.Sprint(item))
+			}
+		default:
+			items = append(items, fmt.Sprint(v))
+		}
+
+		upper := strings.ToUpper(name)
+		if upper == "UNTIL" {
+			for i := range items {
+				items[i] = textDateTime(items[i])
+			}
+		}
+		parts = append(parts, upper+"="+strings.Join(items, ","))
+	}
+	return strings.Join(parts, ";")
+}
@@ -0,0 +1,102 @@
+package ical
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// sampleCalendar はjCal・xCalの相互変換で扱う値型をひととおり含むカレンダーを作成する
+func sampleCalendar() *Component {
+	vtz := NewComponent("VTIMEZONE")
+	vtz.Add("TZID", TypeText, "America/New_York")
+	daylight := NewComponent("DAYLIGHT")
+	daylight.Add("DTSTART", TypeDateTime, "20250309T020000")
+	daylight.Add("TZOFFSETFROM", TypeUTCOffset, "-0500")
+	daylight.Add("TZOFFSETTO", TypeUTCOffset, "-0400")
+	daylight.Add("RRULE", TypeRecur, "FREQ=YEARLY;BYDAY=2SU;BYMONTH=3")
+	vtz.Children = append(vtz.Children, daylight)
+
+	event := NewComponent("VEVENT")
+	event.Add("UID", TypeText, "event-1")
+	event.Add("DTSTART", TypeDateTime, "20250106T090000", Param{Name: "TZID", Value: "America/New_York"})
+	event.Add("DURATION", TypeDuration, "PT1H")
+	event.Add("RRULE", TypeRecur, "FREQ=WEEKLY;BYDAY=MO,WE;INTERVAL=2;UNTIL=20250331T000000Z")
+	event.Add("EXDATE", TypeDateTime, "20250113T140000Z,20250115T140000Z")
+	event.Add("RDATE", TypeDate, "20250201", Param{Name: "VALUE", Value: "DATE"})
+	event.Add("SUMMARY", TypeText, "standup, daily")
+
+	fb := NewComponent("VFREEBUSY")
+	fb.Add("FREEBUSY", TypePeriod, "20250106T140000Z/20250106T150000Z,20250107T140000Z/PT30M", Param{Name: "FBTYPE", Value: "BUSY"})
+
+	vcal := NewComponent("VCALENDAR")
+	vcal.Add("VERSION", TypeText, "2.0")
+	vcal.Children = append(vcal.Children, vtz, event, fb)
+	return vcal
+}
+
+func TestJCalRoundTrip(t *testing.T) {
+	want := sampleCalendar()
+	data, err := MarshalJCal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ParseJCal(data)
+	if err != nil {
+		t.Fatalf("ParseJCal(%s): %v", data, err)
+	}
+	if string(Marshal(got)) != string(Marshal(want)) {
+		t.Errorf("round trip changed the calendar:\n%s\nwant:\n%s", Marshal(got), Marshal(want))
+	}
+}
+
+func TestMarshalJCalValues(t *testing.T) {
+	data, err := MarshalJCal(sampleCalendar())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var raw []any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatal(err)
+	}
+	event := raw[2].([]any)[1].([]any)
+	props := make(map[string][]any)
+	for _, p := range event[1].([]any) {
+		prop := p.([]any)
+		props[prop[0].(string)] = prop[1:]
+	}
+
+	tests := []struct {
+		name string
+		want []any
+	}{
+		{"dtstart", []any{map[string]any{"tzid": "America/New_York"}, "date-time", "2025-01-06T09:00:00"}},
+		{"exdate", []any{map[string]any{}, "date-time", "2025-01-13T14:00:00Z", "2025-01-15T14:00:00Z"}},
+		// VALUEパラメータは値型の要素で表す
+		{"rdate", []any{map[string]any{}, "date", "2025-02-01"}},
+		// 数値の要素は数値、複数の値は配列にする
+		{"rrule", []any{map[string]any{}, "recur", map[string]any{
+			"freq": "WEEKLY", "byday": []any{"MO", "WE"}, "interval": float64(2), "until": "2025-03-31T00:00:00Z",
+		}}},
+	}
+	for _, tt := range tests {
+		if got := props[tt.name]; !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s = %#v, want %#v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParseJCalErrors(t *testing.T) {
+	tests := []string{
+		`{"vcalendar": []}`,
+		`["vcalendar", []]`,
+		`["vcalendar", [["dtstart", {}, "date-time"]], []]`,
+		`["vcalendar", [["rrule", {}, "recur", "FREQ=DAILY"]], []]`,
+		`["vcalendar", [["freebusy", {}, "period", "20250106T140000Z"]], []]`,
+	}
+	for _, data := range tests {
+		if _, err := ParseJCal([]byte(data)); err == nil {
+			t.Errorf("ParseJCal(%s) succeeded, want an error", data)
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package ical
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/recurrence-scheduler/internal/models"
+)
+
+const (
+	// ProdID はこのサーバーが生成するiCalendarのPRODID
+	ProdID = "-//recurrence-scheduler//recurrence-scheduler//EN"
+
+	localLayout = "20060102T150405"
+	utcLayout   = "20060102T150405Z"
+)
+
+// isUTC はタイムゾーン名がUTCを表すかを返す
+func isUTC(tz string) bool {
+	return tz == "" || tz == "UTC" || tz == "Etc/UTC"
+}
+
+// location はIANAタイムゾーン名からLocationを取得する（読み込めない場合はUTC）
+func location(tz string) *time.Location {
+	if isUTC(tz) {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// addDateTime はTZID付きの日時プロパティを追加する
+// UTCの場合はTZIDを付けずにUTC形式で出力する
+func addDateTime(c *Component, name string, tz string, ts ...time.Time) {
+	if len(ts) == 0 {
+		return
+	}
+
+	loc := location(tz)
+	values := make([]string, len(ts))
+	for i, t := range ts {
+		if loc == time.UTC {
+			values[i] = t.UTC().Format(utcLayout)
+		} else {
+			values[i] = t.In(loc).Format(localLayout)
+		}
+	}
+
+	if loc == time.UTC {
+		c.Add(name, TypeDateTime, strings.Join(values, ","))
+	} else {
+		c.Add(name, TypeDateTime, strings.Join(values, ","), Param{Name: "TZID", Value: loc.String()})
+	}
+}
+
+// addDate は終日イベントの日付プロパティをDATE型で追加する
+// 日付はイベントのタイムゾーンで数えるため、TZIDは付けない
+func addDate(c *Component, name string, tz string, ts ...time.Time) {
+	if len(ts) == 0 {
+		return
+	}
+
+	loc := location(tz)
+	values := make([]string, len(ts))
+	for i, t := range ts {
+		values[i] = t.In(loc).Format(dateLayout)
+	}
+	c.Add(name, TypeDate, strings.Join(values, ","), Param{Name: "VALUE", Value: string(TypeDate)})
+}
+
+// UID はイベントのiCalendar上のUIDを返す
+// 取り込んだイベントは元のUIDを、それ以外はイベントID（オーバーライドは元のイベントID）を使う
+func UID(e *models.Event) string {
+	switch {
+	case e.UID != "":
+		return e.UID
+	case e.IsOverride():
+		return e.RecurringEventID
+	default:
+		return e.ID
+	}
+}
+
+// EventComponent はイベントをVEVENTに変換する
+// オーバーライドは元の繰り返しイベントと同じUIDにRECURRENCE-IDを付けて出力する
+func EventComponent(e *models.Event) *Component {
+	vevent := NewComponent("VEVENT")
+	vevent.Add("UID", TypeText, UID(e))
+	vevent.Add("DTSTAMP", TypeDateTime, e.UpdatedAt.UTC().Format(utcLayout))
+	vevent.Add("CREATED", TypeDateTime, e.CreatedAt.UTC().Format(utcLayout))
+	vevent.Add("LAST-MODIFIED", TypeDateTime, e.UpdatedAt.UTC().Format(utcLayout))
+
+	addTimes := addDateTime
+	if e.AllDay {
+		addTimes = addDate
+	}
+	if e.IsOverride() {
+		addTimes(vevent, "RECURRENCE-ID", e.Timezone, e.RecurrenceID)
+	}
+	addTimes(vevent, "DTSTART", e.Timezone, e.DTStart)
+	addTimes(vevent, "DTEND", e.Timezone, e.DTEnd)
+
+	if e.RRule != "" {
+		vevent.Add("RRULE", TypeRecur, strings.TrimPrefix(e.RRule, "RRULE:"))
+	}
+	addTimes(vevent, "RDATE", e.Timezone, e.RDates...)
+	addTimes(vevent, "EXDATE", e.Timezone, e.ExDates...)
+
+	vevent.Add("SUMMARY", TypeText, e.Title)
+	if e.Description != "" {
+		vevent.Add("DESCRIPTION", TypeText, e.Description)
+	}
+	if e.RelatedTo != "" {
+		vevent.Add("RELATED-TO", TypeText, e.RelatedTo)
+	}
+
+	for i := range e.Reminders {
+		vevent.Children = append(vevent.Children, AlarmComponent(e, &e.Reminders[i]))
+	}
+
+	return vevent
+}
+
+// AlarmComponent はリマインダーをVALARMに変換する
+// DESCRIPTION（EMAILの場合はSUMMARYも）が必須のため、リマインダーに説明がなければイベントのタイトルを使う
+func AlarmComponent(e *models.Event, r *models.Reminder) *Component {
+	valarm := NewComponent("VALARM")
+	valarm.Add("UID", TypeText, r.ID)
+	valarm.Add("ACTION", TypeText, string(r.Action))
+
+	switch {
+	case r.IsAbsolute():
+		valarm.Add("TRIGGER", TypeDateTime, r.At.UTC().Format(utcLayout), Param{Name: "VALUE", Value: string(TypeDateTime)})
+	case r.Related == models.RelatedEnd:
+		valarm.Add("TRIGGER", TypeDuration, FormatDuration(r.Offset), Param{Name: "RELATED", Value: string(models.RelatedEnd)})
+	default:
+		valarm.Add("TRIGGER", TypeDuration, FormatDuration(r.Offset))
+	}
+
+	description := r.Description
+	if description == "" {
+		description = e.Title
+	}
+	valarm.Add("DESCRIPTION", TypeText, description)
+
+	if r.Action == models.ReminderEmail {
+		valarm.Add("SUMMARY", TypeText, e.Title)
+		for _, recipient := range r.Recipients {
+			valarm.Add("ATTENDEE", TypeCalAddress, "mailto:"+recipient)
+		}
+	}
+	return valarm
+}
+
+// CalendarComponent はカレンダーとイベントをVCALENDARに変換する
+// イベントが使用しているすべてのタイムゾーンについてVTIMEZONEを生成する
+func CalendarComponent(cal *models.Calendar, events []*models.Event) *Component {
+	vcal := newVCalendar()
+	vcal.Add("METHOD", TypeText, "PUBLISH")
+	if cal != nil {
+		vcal.Add("X-WR-CALNAME", TypeText, cal.Name)
+		if cal.Description != "" {
+			vcal.Add("X-WR-CALDESC", TypeText, cal.Description)
+		}
+		vcal.Add("X-WR-TIMEZONE", TypeText, cal.Timezone)
+	}
+
+	addEvents(vcal, events)
+	return vcal
+}
+
+// ObjectComponent は1つのUIDに属するイベント（繰り返しイベントとそのオーバーライド）を
+// CalDAVのカレンダーオブジェクトリソースとしてVCALENDARに変換する
+// RFC 4791 4.1によりMETHODプロパティは含めない
+func ObjectComponent(events []*models.Event) *Component {
+	vcal := newVCalendar()
+	addEvents(vcal, events)
+	return vcal
+}
+
+func newVCalendar() *Component {
+	vcal := NewComponent("VCALENDAR")
+	vcal.Add("VERSION", TypeText, "2.0")
+	vcal.Add("PRODID", TypeText, ProdID)
+	vcal.Add("CALSCALE", TypeText, "GREGORIAN")
+	return vcal
+}
+
+// addEvents はVTIMEZONEとVEVENTをVCALENDARに追加する
+func addEvents(vcal *Component, events []*models.Event) {
+	vcal.Children = append(vcal.Children, Timezones(events)...)
+	for _, e := range events {
+		vcal.Children = append(vcal.Children, EventComponent(e))
+	}
+}
+
+// Timezones はイベントが使用しているUTC以外のタイムゾーンのVTIMEZONEをTZID順で返す
+// 終日イベントはTZIDを付けずに出力するため対象にしない
+// 切り替えはTimezoneYearsの範囲の年について含める
+func Timezones(events []*models.Event) []*Component {
+	fromYear, toYear := TimezoneYears(events)
+	zones := make(map[string]*time.Location)
+	for _, e := range events {
+		if loc := location(e.Timezone); loc != time.UTC && !e.AllDay {
+			zones[loc.String()] = loc
+		}
+	}
+
+	names := make([]string, 0, len(zones))
+	for name := range zones {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var vtzs []*Component
+	for _, name := range names {
+		vtzs = append(vtzs, VTimezone(zones[name], fromYear, toYear))
+	}
+	return vtzs
+}
+
+// TimezoneYears はTimezonesが切り替えを含める年の範囲を返す
+// 最も早いイベントの年から、最も遅いイベントの年または今年までになるため、イベントが変わらなくても年が変わると広がる
+func TimezoneYears(events []*models.Event) (fromYear, toYear int) {
+	fromYear, toYear = time.Now().Year(), time.Now().Year()
+	for _, e := range events {
+		if location(e.Timezone) == time.UTC || e.AllDay {
+			continue
+		}
+		if y := e.DTStart.Year(); y < fromYear {
+			fromYear = y
+		}
+		if y := e.DTEnd.Year(); y > toYear {
+			toYear = y
+		}
+	}
+	return fromYear, toYear
+}
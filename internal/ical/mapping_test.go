@@ -0,0 +1,190 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/recurrence-scheduler/internal/models"
+)
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("time zone %s is not available: %v", name, err)
+	}
+	return loc
+}
+
+// lines はコンポーネントを出力した各行を返す
+func lines(c *Component) []string {
+	return strings.Split(strings.TrimSpace(string(Marshal(c))), "\r\n")
+}
+
+func containsAll(t *testing.T, got []string, want []string) {
+	t.Helper()
+	for _, w := range want {
+		found := false
+		for _, line := range got {
+			if line == w {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("missing %q in\n%s", w, strings.Join(got, "\n"))
+		}
+	}
+}
+
+func TestVTimezone(t *testing.T) {
+	tests := []struct {
+		zone string
+		want []string
+	}{
+		{
+			zone: "America/New_York",
+			want: []string{
+				"TZID:America/New_York",
+				"BEGIN:DAYLIGHT", "DTSTART:20250309T020000", "TZOFFSETFROM:-0500", "TZOFFSETTO:-0400", "TZNAME:EDT",
+				"RRULE:FREQ=YEARLY;BYMONTH=3;BYDAY=2SU",
+				"BEGIN:STANDARD", "DTSTART:20251102T020000", "TZOFFSETFROM:-0400", "TZOFFSETTO:-0500", "TZNAME:EST",
+				"RRULE:FREQ=YEARLY;BYMONTH=11;BYDAY=1SU",
+			},
+		},
+		{
+			// 最終日曜日の切り替えは-1SUで表す
+			zone: "Europe/London",
+			want: []string{
+				"DTSTART:20250330T010000", "RRULE:FREQ=YEARLY;BYMONTH=3;BYDAY=-1SU",
+				"DTSTART:20251026T020000", "RRULE:FREQ=YEARLY;BYMONTH=10;BYDAY=-1SU",
+			},
+		},
+		{
+			// 切り替えのないタイムゾーンは固定のSTANDARDだけにする
+			zone: "Asia/Tokyo",
+			want: []string{"BEGIN:STANDARD", "DTSTART:19700101T000000", "TZOFFSETFROM:+0900", "TZOFFSETTO:+0900", "TZNAME:JST"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.zone, func(t *testing.T) {
+			containsAll(t, lines(VTimezone(mustLoad(t, tt.zone), 2025, 2025)), tt.want)
+		})
+	}
+}
+
+func TestEventComponent(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+	start := time.Date(2025, 1, 6, 9, 0, 0, 0, ny)
+
+	event := models.NewEvent("cal", "standup", "daily sync", start.UTC(), start.Add(time.Hour).UTC(), "FREQ=WEEKLY;BYDAY=MO", "America/New_York")
+	event.ExDates = []time.Time{start.AddDate(0, 0, 7).UTC()}
+	utcEvent := models.NewEvent("cal", "utc", "", start.UTC(), start.Add(time.Hour).UTC(), "", "UTC")
+	override := recurrenceOverride(event, start.AddDate(0, 0, 14), start.AddDate(0, 0, 14).Add(2*time.Hour))
+
+	tests := []struct {
+		name  string
+		event *models.Event
+		want  []string
+	}{
+		{
+			name:  "local times with TZID",
+			event: event,
+			want: []string{
+				"UID:" + event.ID,
+				"DTSTART;TZID=America/New_York:20250106T090000",
+				"DTEND;TZID=America/New_York:20250106T100000",
+				"RRULE:FREQ=WEEKLY;BYDAY=MO",
+				"EXDATE;TZID=America/New_York:20250113T090000",
+				"SUMMARY:standup",
+				"DESCRIPTION:daily sync",
+			},
+		},
+		{
+			name:  "UTC without TZID",
+			event: utcEvent,
+			want:  []string{"DTSTART:20250106T140000Z", "DTEND:20250106T150000Z"},
+		},
+		{
+			name:  "override shares the UID and adds RECURRENCE-ID",
+			event: override,
+			want: []string{
+				"UID:" + event.ID,
+				"RECURRENCE-ID;TZID=America/New_York:20250120T090000",
+				"DTSTART;TZID=America/New_York:20250120T110000",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			containsAll(t, lines(EventComponent(tt.event)), tt.want)
+		})
+	}
+}
+
+// recurrenceOverride はeventのrecurrenceIDのインスタンスをdtStartに移動したオーバーライドを作成する
+func recurrenceOverride(event *models.Event, recurrenceID, dtStart time.Time) *models.Event {
+	override := models.NewEvent(event.CalendarID, event.Title, "", dtStart.UTC(), dtStart.Add(time.Hour).UTC(), "", event.Timezone)
+	override.RecurringEventID = event.ID
+	override.RecurrenceID = recurrenceID.UTC()
+	return override
+}
+
+func TestTimezones(t *testing.T) {
+	mustLoad(t, "America/New_York")
+	mustLoad(t, "Asia/Tokyo")
+	start := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	event := func(tz string, allDay bool) *models.Event {
+		e := models.NewEvent("cal", "e", "", start, start.Add(time.Hour), "", tz)
+		e.AllDay = allDay
+		return e
+	}
+
+	vtzs := Timezones([]*models.Event{
+		event("UTC", false),
+		event("Asia/Tokyo", false),
+		event("America/New_York", false),
+		event("America/New_York", false),
+		// 終日イベントはTZIDを付けずに出力するため、VTIMEZONEは不要
+		event("Europe/London", true),
+	})
+
+	var got []string
+	for _, vtz := range vtzs {
+		got = append(got, vtz.Value("TZID"))
+	}
+	if strings.Join(got, ",") != "America/New_York,Asia/Tokyo" {
+		t.Errorf("TZIDs = %q, want America/New_York and Asia/Tokyo", got)
+	}
+}
+
+func TestExportImportRoundTrip(t *testing.T) {
+	mustLoad(t, "America/New_York")
+	start := time.Date(2025, 3, 3, 14, 0, 0, 0, time.UTC)
+	event := models.NewEvent("cal", "standup", "", start, start.Add(30*time.Minute), "FREQ=WEEKLY;COUNT=4", "America/New_York")
+	event.ExDates = []time.Time{start.AddDate(0, 0, 7).Add(-time.Hour)} // 夏時間開始後の9:00（UTCでは13:00）
+
+	vcal := CalendarComponent(models.NewCalendar("test", "", "UTC"), []*models.Event{event})
+	parsed, err := Parse(strings.NewReader(string(Marshal(vcal))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, events, _, errs := Events(parsed, "UTC")
+	if len(events) != 1 || errs[0] != nil {
+		t.Fatalf("got %d events (errors %v), want 1", len(events), errs)
+	}
+
+	got := events[0]
+	if got.UID != event.ID || got.RRule != event.RRule || got.Timezone != event.Timezone {
+		t.Errorf("got UID %q, RRULE %q, timezone %q", got.UID, got.RRule, got.Timezone)
+	}
+	if !got.DTStart.Equal(event.DTStart) || !got.DTEnd.Equal(event.DTEnd) {
+		t.Errorf("got %v-%v, want %v-%v", got.DTStart, got.DTEnd, event.DTStart, event.DTEnd)
+	}
+	if len(got.ExDates) != 1 || !got.ExDates[0].Equal(event.ExDates[0]) {
+		t.Errorf("EXDATE = %v, want %v", got.ExDates, event.ExDates)
+	}
+}
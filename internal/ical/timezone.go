@@ -0,0 +1,158 @@
+package ical
+
+import (
+	"fmt"
+	"time"
+)
+
+// transition はタイムゾーンのオフセットの切り替え
+type transition struct {
+	at         time.Time // 切り替え時刻（UTC）
+	offsetFrom int
+	offsetTo   int
+	name       string
+	isDST      bool
+}
+
+// transitions は[from, to)に含まれるオフセットの切り替えを列挙する
+// 日単位で走査し、変化があった日を二分探索で秒単位まで絞り込む
+func transitions(loc *time.Location, from, to time.Time) []transition {
+	var result []transition
+
+	prev := from
+	prevName, prevOffset := from.In(loc).Zone()
+	for prev.Before(to) {
+		t := prev.Add(24 * time.Hour)
+		if t.After(to) {
+			t = to
+		}
+
+		name, offset := t.In(loc).Zone()
+		if offset != prevOffset || name != prevName {
+			lo, hi := prev, t
+			for hi.Sub(lo) > time.Second {
+				mid := lo.Add(hi.Sub(lo) / 2)
+				if n, o := mid.In(loc).Zone(); o == prevOffset && n == prevName {
+					lo = mid
+				} else {
+					hi = mid
+				}
+			}
+			result = append(result, transition{
+				at:         hi.Truncate(time.Second),
+				offsetFrom: prevOffset,
+				offsetTo:   offset,
+				name:       name,
+				isDST:      hi.In(loc).IsDST(),
+			})
+		}
+		prev, prevName, prevOffset = t, name, offset
+	}
+
+	return result
+}
+
+// VTimezone はLocationから、fromYearからtoYearまでの切り替えを含むVTIMEZONEを生成する
+// 最終年の切り替えが毎年同じ規則（n番目または最終の曜日）に従う場合はRRULEで表現し、
+// それ以降の年もクライアントが正しく計算できるようにする
+func VTimezone(loc *time.Location, fromYear, toYear int) *Component {
+	vtz := NewComponent("VTIMEZONE")
+	vtz.Add("TZID", TypeText, loc.String())
+
+	from := time.Date(fromYear, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(toYear+1, 1, 1, 0, 0, 0, 0, time.UTC)
+	ts := transitions(loc, from, to)
+
+	if len(ts) == 0 {
+		name, offset := from.In(loc).Zone()
+		std := NewComponent("STANDARD")
+		std.Add("DTSTART", TypeDateTime, "19700101T000000")
+		std.Add("TZOFFSETFROM", TypeUTCOffset, formatOffset(offset))
+		std.Add("TZOFFSETTO", TypeUTCOffset, formatOffset(offset))
+		std.Add("TZNAME", TypeText, name)
+		vtz.Children = append(vtz.Children, std)
+		return vtz
+	}
+
+	for _, t := range ts {
+		local := t.at.Add(time.Duration(t.offsetFrom) * time.Second).UTC()
+
+		var rule string
+		if local.Year() == toYear {
+			rule = yearlyRule(loc, t, local)
+		}
+
+		name := "STANDARD"
+		if t.isDST {
+			name = "DAYLIGHT"
+		}
+		obs := NewComponent(name)
+		obs.Add("DTSTART", TypeDateTime, local.Format(localLayout))
+		obs.Add("TZOFFSETFROM", TypeUTCOffset, formatOffset(t.offsetFrom))
+		obs.Add("TZOFFSETTO", TypeUTCOffset, formatOffset(t.offsetTo))
+		obs.Add("TZNAME", TypeText, t.name)
+		if rule != "" {
+			obs.Add("RRULE", TypeRecur, rule)
+		}
+		vtz.Children = append(vtz.Children, obs)
+	}
+
+	return vtz
+}
+
+// yearlyRule は切り替えが以降の数年も同じ月・曜日・時刻で起きる場合に、それを表すRRULEを返す
+func yearlyRule(loc *time.Location, t transition, local time.Time) string {
+	const probeYears = 3
+
+	nth := (local.Day()-1)/7 + 1
+	last := local.AddDate(0, 0, 7).Month() != local.Month()
+	matchNth, matchLast := true, last
+
+	for y := 1; y <= probeYears; y++ {
+		from := time.Date(local.Year()+y, local.Month(), 1, 0, 0, 0, 0, time.UTC)
+		next := transitions(loc, from, from.AddDate(0, 1, 0))
+		found := false
+		for _, n := range next {
+			nl := n.at.Add(time.Duration(n.offsetFrom) * time.Second).UTC()
+			if n.offsetFrom != t.offsetFrom || n.offsetTo != t.offsetTo ||
+				nl.Weekday() != local.Weekday() || nl.Hour() != local.Hour() || nl.Minute() != local.Minute() {
+				continue
+			}
+			found = true
+			if (nl.Day()-1)/7+1 != nth {
+				matchNth = false
+			}
+			if nl.AddDate(0, 0, 7).Month() == nl.Month() {
+				matchLast = false
+			}
+		}
+		if !found {
+			return ""
+		}
+	}
+
+	day := weekdayCodes[local.Weekday()]
+	switch {
+	case matchLast:
+		return fmt.Sprintf("FREQ=YEARLY;BYMONTH=%d;BYDAY=-1%s", int(local.Month()), day)
+	case matchNth:
+		return fmt.Sprintf("FREQ=YEARLY;BYMONTH=%d;BYDAY=%d%s", int(local.Month()), nth, day)
+	}
+	return ""
+}
+
+var weekdayCodes = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// formatOffset は秒単位のオフセットを±hhmm[ss]形式にする
+func formatOffset(offset int) string {
+	sign := '+'
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+	h, m, s := offset/3600, offset%3600/60, offset%60
+	if s != 0 {
+		return fmt.Sprintf("%c%02d%02d%02d", sign, h, m, s)
+	}
+	return fmt.Sprintf("%c%02d%02d", sign, h, m)
+}
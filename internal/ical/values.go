@@ -0,0 +1,52 @@
+package ical
+
+import "strings"
+
+// jCal・xCalではDATE-TIME・DATE・UTC-OFFSETをRFC 3339の拡張形式で表す
+// ここではiCalendarの基本形式との相互変換を扱う
+
+// extendedDateTime は 20250106T090000[Z] を 2025-01-06T09:00:00[Z] にする
+func extendedDateTime(v string) string {
+	if len(v) < len(localLayout) {
+		return extendedDate(v)
+	}
+	return extendedDate(v[:8]) + "T" + v[9:11] + ":" + v[11:13] + ":" + v[13:]
+}
+
+// extendedDate は 20250106 を 2025-01-06 にする
+func extendedDate(v string) string {
+	if len(v) != len("20060102") {
+		return v
+	}
+	return v[:4] + "-" + v[4:6] + "-" + v[6:]
+}
+
+// extendedOffset は +0100 を +01:00 にする
+func extendedOffset(v string) string {
+	if len(v) < 5 {
+		return v
+	}
+	s := v[:3] + ":" + v[3:5]
+	if len(v) == 7 {
+		s += ":" + v[5:]
+	}
+	return s
+}
+
+// basicValue は拡張形式の値をiCalendarの基本形式に戻す
+func basicValue(typ ValueType, s string) string {
+	switch typ {
+	case TypeDateTime:
+		return textDateTime(s)
+	case TypeDate:
+		return strings.ReplaceAll(s, "-", "")
+	case TypeUTCOffset:
+		return strings.ReplaceAll(s, ":", "")
+	}
+	return s
+}
+
+// textDateTime は 2025-01-06T09:00:00[Z] を 20250106T090000[Z] にする
+func textDateTime(s string) string {
+	return strings.NewReplacer("-", "", ":", "").Replace(s)
+}
@@ -0,0 +1,296 @@
+package ical
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// XCalContentType はxCal（RFC 6321）のContent-Type
+const XCalContentType = "application/calendar+xml"
+
+// xcalNamespace はxCalの要素のXML名前空間
+const xcalNamespace = "urn:ietf:params:xml:ns:icalendar-2.0"
+
+// xcalParamTypes はTEXT以外の値を持つパラメータの値型（RFC 6321 3.5）
+var xcalParamTypes = map[string]ValueType{
+	"ALTREP":         TypeURI,
+	"DELEGATED-FROM": TypeCalAddress,
+	"DELEGATED-TO":   TypeCalAddress,
+	"DIR":            TypeURI,
+	"MEMBER":         TypeCalAddress,
+	"SENT-BY":        TypeCalAddress,
+}
+
+// xmlNode はxCalの要素を表す。要素は子要素かテキストのどちらか一方を持つ
+type xmlNode struct {
+	XMLName xml.Name
+	Attrs   []xml.Attr `xml:",any,attr"`
+	Nodes   []*xmlNode `xml:",any"`
+	Text    string     `xml:",chardata"`
+}
+
+func element(name string, children ...*xmlNode) *xmlNode {
+	return &xmlNode{XMLName: xml.Name{Local: name}, Nodes: children}
+}
+
+func textElement(name, text string) *xmlNode {
+	return &xmlNode{XMLName: xml.Name{Local: name}, Text: text}
+}
+
+// MarshalXCal はコンポーネントをxCal形式のXMLに変換する
+func MarshalXCal(c *Component) ([]byte, error) {
+	// 子要素に空の名前空間が付かないよう、名前空間はルートの属性として宣言する
+	root := element("icalendar", xcalComponent(c))
+	root.Attrs = []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: xcalNamespace}}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(root); err != nil {
+		return nil, err
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+// xcalComponent はコンポーネントを <name><properties/><components/></name> にする
+func xcalComponent(c *Component) *xmlNode {
+	node := element(strings.ToLower(c.Name))
+	if len(c.Properties) > 0 {
+		props := element("properties")
+		for _, p := range c.Properties {
+			props.Nodes = append(props.Nodes, xcalProperty(p))
+		}
+		node.Nodes = append(node.Nodes, props)
+	}
+	if len(c.Children) > 0 {
+		children := element("components")
+		for _, child := range c.Children {
+			children.Nodes = append(children.Nodes, xcalComponent(child))
+		}
+		node.Nodes = append(node.Nodes, children)
+	}
+	return node
+}
+
+// xcalProperty はプロパティを <name><parameters/><type>value</type>...</name> にする
+// 値型は値の要素名で表すため、VALUEパラメータは含めない
+func xcalProperty(p *Property) *xmlNode {
+	node := element(strings.ToLower(p.Name))
+
+	var params []*xmlNode
+	for _, param := range p.Params {
+		if param.Name == "VALUE" {
+			continue
+		}
+		typ, ok := xcalParamTypes[param.Name]
+		if !ok {
+			typ = TypeText
+		}
+		params = append(params, element(strings.ToLower(param.Name), textElement(strings.ToLower(string(typ)), param.Value)))
+	}
+	if len(params) > 0 {
+		node.Nodes = append(node.Nodes, element("parameters", params...))
+	}
+
+	node.Nodes = append(node.Nodes, xcalValues(p)...)
+	return node
+}
+
+// xcalValues はプロパティの値をxCalの値要素に変換する
+func xcalValues(p *Property) []*xmlNode {
+	typ := strings.ToLower(string(p.Type))
+	switch p.Type {
+	case TypeText, TypeDuration, TypeURI, TypeCalAddress:
+		return []*xmlNode{textElement(typ, p.Value)}
+	case TypeRecur:
+		return []*xmlNode{xcalRecur(p.Value)}
+	}
+
+	var values []*xmlNode
+	for _, v := range strings.Split(p.Value, ",") {
+		switch p.Type {
+		case TypeDateTime:
+			values = append(values, textElement(typ, extendedDateTime(v)))
+		case TypeDate:
+			values = append(values, textElement(typ, extendedDate(v)))
+		case TypePeriod:
+			start, end, _ := strings.Cut(v, "/")
+			period := element(typ, textElement("start", extendedDateTime(start)))
+			if strings.Contains(end, "P") {
+				period.Nodes = append(period.Nodes, textElement("duration", end))
+			} else {
+				period.Nodes = append(period.Nodes, textElement("end", extendedDateTime(end)))
+			}
+			values = append(values, period)
+		case TypeUTCOffset:
+			values = append(values, textElement(typ, extendedOffset(v)))
+		default:
+			values = append(values, textElement(typ, v))
+		}
+	}
+	return values
+}
+
+// xcalRecur はRECUR値を要素にする（RFC 6321 3.6.10）
+// 複数の値を持つ要素は、値ごとに同じ名前の要素を繰り返す
+func xcalRecur(v string) *xmlNode {
+	recur := element("recur")
+	for _, part := range strings.Split(v, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok {
+			continue
+		}
+		name = strings.ToUpper(name)
+		for _, item := range strings.Split(value, ",") {
+			if name == "UNTIL" {
+				item = extendedDateTime(item)
+			}
+			recur.Nodes = append(recur.Nodes, textElement(strings.ToLower(name), item))
+		}
+	}
+	return recur
+}
+
+// ParseXCal はxCal形式のXMLを解析し、最初のトップレベルコンポーネントを返す
+// 変換後はParseの結果と同じ形になるため、iCalendarと同じ処理で扱える
+func ParseXCal(data []byte) (*Component, error) {
+	var root xmlNode
+	if err := xml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("invalid xCal: %w", err)
+	}
+	if root.XMLName.Local != "icalendar" {
+		return nil, fmt.Errorf("invalid xCal: unexpected root element %s", root.XMLName.Local)
+	}
+	if len(root.Nodes) == 0 {
+		return nil, errors.New("no component found")
+	}
+	return parseXCalComponent(root.Nodes[0])
+}
+
+func parseXCalComponent(node *xmlNode) (*Component, error) {
+	c := NewComponent(strings.ToUpper(node.XMLName.Local))
+	for _, child := range node.Nodes {
+		switch child.XMLName.Local {
+		case "properties":
+			for _, p := range child.Nodes {
+				prop, err := parseXCalProperty(p)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", c.Name, err)
+				}
+				c.Properties = append(c.Properties, prop)
+			}
+		case "components":
+			for _, sub := range child.Nodes {
+				subComponent, err := parseXCalComponent(sub)
+				if err != nil {
+					return nil, err
+				}
+				c.Children = append(c.Children, subComponent)
+			}
+		default:
+			return nil, fmt.Errorf("invalid xCal: unexpected element %s in %s", child.XMLName.Local, c.Name)
+		}
+	}
+	return c, nil
+}
+
+func parseXCalProperty(node *xmlNode) (*Property, error) {
+	prop := &Property{Name: strings.ToUpper(node.XMLName.Local)}
+
+	var values []*xmlNode
+	for _, child := range node.Nodes {
+		if child.XMLName.Local != "parameters" {
+			values = append(values, child)
+			continue
+		}
+		for _, param := range child.Nodes {
+			var items []string
+			for _, v := range param.Nodes {
+				items = append(items, v.Text)
+			}
+			prop.Params = append(prop.Params, Param{Name: strings.ToUpper(param.XMLName.Local), Value: strings.Join(items, ",")})
+		}
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("%s: missing value", prop.Name)
+	}
+
+	typ := values[0].XMLName.Local
+	prop.Type = ValueType(strings.ToUpper(typ))
+	if typ == "unknown" {
+		prop.Type = TypeText
+	}
+	// 既定と異なる値型はテキスト形式に戻したときのためVALUEパラメータで表す
+	if typ != "unknown" && prop.Type != PropertyType(prop.Name, "") {
+		prop.Params = append(prop.Params, Param{Name: "VALUE", Value: string(prop.Type)})
+	}
+
+	items := make([]string, 0, len(values))
+	for _, v := range values {
+		if v.XMLName.Local != typ {
+			return nil, fmt.Errorf("%s: mixed value types %s and %s", prop.Name, typ, v.XMLName.Local)
+		}
+		switch prop.Type {
+		case TypeRecur:
+			items = append(items, xcalTextRecur(v))
+		case TypePeriod:
+			item, err := xcalTextPeriod(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", prop.Name, err)
+			}
+			items = append(items, item)
+		default:
+			items = append(items, basicValue(prop.Type, v.Text))
+		}
+	}
+	prop.Value = strings.Join(items, ",")
+	return prop, nil
+}
+
+// xcalTextRecur はrecur要素をRECUR値に戻す
+// 繰り返された同じ名前の要素はカンマ区切りの1つの要素にまとめる
+func xcalTextRecur(node *xmlNode) string {
+	var names []string
+	items := make(map[string][]string)
+	for _, child := range node.Nodes {
+		name := strings.ToUpper(child.XMLName.Local)
+		if _, ok := items[name]; !ok {
+			names = append(names, name)
+		}
+		value := child.Text
+		if name == "UNTIL" {
+			value = textDateTime(value)
+		}
+		items[name] = append(items[name], value)
+	}
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, name+"="+strings.Join(items[name], ","))
+	}
+	return strings.Join(parts, ";")
+}
+
+// xcalTextPeriod はperiod要素をPERIOD値に戻す
+func xcalTextPeriod(node *xmlNode) (string, error) {
+	var start, end string
+	for _, child := range node.Nodes {
+		switch child.XMLName.Local {
+		case "start":
+			start = textDateTime(child.Text)
+		case "end":
+			end = textDateTime(child.Text)
+		case "duration":
+			end = child.Text
+		}
+	}
+	if start == "" || end == "" {
+		return "", errors.New("PERIOD value must have start and end or duration")
+	}
+	return start + "/" + end, nil
+}
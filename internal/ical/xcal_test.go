@@ -0,0 +1,67 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestXCalRoundTrip(t *testing.T) {
+	want := sampleCalendar()
+	data, err := MarshalXCal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ParseXCal(data)
+	if err != nil {
+		t.Fatalf("ParseXCal(%s): %v", data, err)
+	}
+	if string(Marshal(got)) != string(Marshal(want)) {
+		t.Errorf("round trip changed the calendar:\n%s\nwant:\n%s", Marshal(got), Marshal(want))
+	}
+}
+
+func TestMarshalXCalValues(t *testing.T) {
+	data, err := MarshalXCal(sampleCalendar())
+	if err != nil {
+		t.Fatal(err)
+	}
+	xml := string(data)
+
+	for _, want := range []string{
+		`<icalendar xmlns="urn:ietf:params:xml:ns:icalendar-2.0">`,
+		"<fbtype>",
+		"<text>BUSY</text>",
+		"<date-time>2025-01-06T09:00:00</date-time>",
+		"<utc-offset>-05:00</utc-offset>",
+		"<date>2025-02-01</date>",
+		"<byday>MO</byday>",
+		"<byday>WE</byday>",
+		"<until>2025-03-31T00:00:00Z</until>",
+		"<duration>PT30M</duration>",
+		"<end>2025-01-06T15:00:00Z</end>",
+	} {
+		if !strings.Contains(xml, want) {
+			t.Errorf("missing %q in\n%s", want, xml)
+		}
+	}
+	// VALUEパラメータは値型の要素名で表す
+	if strings.Contains(xml, "<value>") {
+		t.Errorf("VALUE parameter was not dropped:\n%s", xml)
+	}
+}
+
+func TestParseXCalErrors(t *testing.T) {
+	tests := []string{
+		`<vcalendar/>`,
+		`<icalendar xmlns="urn:ietf:params:xml:ns:icalendar-2.0"></icalendar>`,
+		`<icalendar><vcalendar><extra/></vcalendar></icalendar>`,
+		`<icalendar><vcalendar><properties><version/></properties></vcalendar></icalendar>`,
+		`<icalendar><vcalendar><properties><exdate><date>2025-01-01</date><date-time>2025-01-02T00:00:00Z</date-time></exdate></properties></vcalendar></icalendar>`,
+		`<icalendar><vcalendar><properties><freebusy><period><start>2025-01-06T14:00:00Z</start></period></freebusy></properties></vcalendar></icalendar>`,
+	}
+	for _, data := range tests {
+		if _, err := ParseXCal([]byte(data)); err == nil {
+			t.Errorf("ParseXCal(%s) succeeded, want an error", data)
+		}
+	}
+}
@@ -12,6 +12,7 @@ type Calendar struct {
 	Name        string    `json:"name"`
 	Description string    `json:"description"`
 	Timezone    string    `json:"timezone"`
+	FeedToken   string    `json:"-"` // 購読フィードのトークン（空の場合はフィードを公開しない）
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
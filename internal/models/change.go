@@ -0,0 +1,43 @@
+package models
+
+import "time"
+
+// ChangeType は変更の種別
+type ChangeType string
+
+const (
+	ChangeCreated ChangeType = "created"
+	ChangeUpdated ChangeType = "updated"
+	ChangeDeleted ChangeType = "deleted"
+
+	// OccurrenceStarted は変更ではなく、インスタンスの開始時刻になったことを表す
+	// Webhookで明示的に指定した場合のみ通知する
+	OccurrenceStarted ChangeType = "occurrence_started"
+	// ReminderDue はインスタンスのリマインダーの時刻になったことを表す（OccurrenceStartedと同様に指定した場合のみ通知する）
+	ReminderDue ChangeType = "reminder_due"
+)
+
+// EventName はWebhookのペイロードのtypeに使う名前を返す（"event.created"、"occurrence.started"など）
+func (t ChangeType) EventName() string {
+	switch t {
+	case OccurrenceStarted:
+		return "occurrence.started"
+	case ReminderDue:
+		return "reminder.due"
+	}
+	return "event." + string(t)
+}
+
+// Change はイベントの変更履歴の1件を表現する
+// 変更は繰り返しイベント単位で記録し、オーバーライドの変更は元のイベントの変更として扱う
+type Change struct {
+	Seq        int64  `json:"seq"`
+	CalendarID string `json:"calendar_id"`
+	EventID    string `json:"event_id"`
+	// UIDとResourceNameは削除されたイベントの場合のみ記録する
+	UID          string     `json:"uid,omitempty"`
+	ResourceName string     `json:"resource_name,omitempty"`
+	Type         ChangeType `json:"type"`
+	Deleted      bool       `json:"deleted"`
+	ChangedAt    time.Time  `json:"changed_at"`
+}
@@ -8,16 +8,25 @@ import (
 
 // Event はイベントを表現する
 type Event struct {
-	ID          string    `json:"id"`
-	CalendarID  string    `json:"calendar_id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	DTStart     time.Time `json:"dtstart"`
-	DTEnd       time.Time `json:"dtend"`
-	RRule       string    `json:"rrule"` // RFC 5545形式のRRULE文字列
-	Timezone    string    `json:"timezone"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID               string      `json:"id"`
+	UID              string      `json:"uid,omitempty"`           // 取り込んだiCalendarのUID（空の場合はIDをUIDとして扱う）
+	ResourceName     string      `json:"resource_name,omitempty"` // CalDAVクライアントが指定したリソース名
+	CalendarID       string      `json:"calendar_id"`
+	Title            string      `json:"title"`
+	Description      string      `json:"description"`
+	DTStart          time.Time   `json:"dtstart"`
+	DTEnd            time.Time   `json:"dtend"`
+	RRule            string      `json:"rrule"`   // RFC 5545形式のRRULE文字列
+	ExDates          []time.Time `json:"exdates"` // 除外日時（EXDATE）
+	RDates           []time.Time `json:"rdates"`  // 追加日時（RDATE）
+	Timezone         string      `json:"timezone"`
+	AllDay           bool        `json:"all_day,omitempty"`            // 終日イベント（DTSTART/DTENDはタイムゾーンの0時で、iCalendarではDATE型で出力する）
+	RecurringEventID string      `json:"recurring_event_id,omitempty"` // インスタンス・オーバーライドの元になった繰り返しイベントのID
+	RecurrenceID     time.Time   `json:"recurrence_id,omitempty"`      // 置き換え対象のインスタンス開始時刻（RECURRENCE-ID）
+	RelatedTo        string      `json:"related_to,omitempty"`         // 分割元の繰り返しイベントのID（RELATED-TO）
+	Reminders        []Reminder  `json:"reminders,omitempty"`          // リマインダー（VALARM）
+	CreatedAt        time.Time   `json:"created_at"`
+	UpdatedAt        time.Time   `json:"updated_at"`
 }
 
 // NewEvent は新しいイベントを作成する
@@ -36,3 +45,8 @@ func NewEvent(calendarID, title, description string, dtStart, dtEnd time.Time, r
 		UpdatedAt:   now,
 	}
 }
+
+// IsOverride はイベントが繰り返しイベントの1インスタンスを置き換えるオーバーライドかを返す
+func (e *Event) IsOverride() bool {
+	return e.RecurringEventID != "" && !e.RecurrenceID.IsZero()
+}
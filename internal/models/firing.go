@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// FiringStatus はインスタンスの開始に対するアクションの実行状態
+type FiringStatus string
+
+const (
+	// FiringPending は記録済みでアクションの実行が終わっていない
+	FiringPending FiringStatus = "pending"
+	// FiringDone はすべてのアクションを実行した
+	FiringDone FiringStatus = "done"
+)
+
+// Firing は繰り返しイベントの1インスタンスが開始したこと、またはそのリマインダーの時刻になったことを表す
+// IDは元のインスタンスの開始時刻（RECURRENCE-ID）とリマインダーのIDから決まるため、同じインスタンスは一度しか記録されない
+type Firing struct {
+	ID         string `json:"id"`
+	EventID    string `json:"event_id"` // 繰り返しの親イベント（単発イベントの場合はそのイベント）のID
+	CalendarID string `json:"calendar_id"`
+	// Instanceは開始したインスタンス（オーバーライドがあればオーバーライド）
+	Instance *Event `json:"instance"`
+	// Reminderはリマインダーの発火の場合のみ設定する
+	Reminder *Reminder    `json:"reminder,omitempty"`
+	Status   FiringStatus `json:"status"`
+	FiredAt  time.Time    `json:"fired_at"`
+}
+
+// Type はWebhookで通知する際の種別を返す
+func (f *Firing) Type() ChangeType {
+	if f.Reminder != nil {
+		return ReminderDue
+	}
+	return OccurrenceStarted
+}
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReminderAction はリマインダーの通知方法（VALARMのACTION）
+type ReminderAction string
+
+const (
+	// ReminderDisplay は画面やログに表示する
+	ReminderDisplay ReminderAction = "DISPLAY"
+	// ReminderEmail はRecipientsにメールを送る
+	ReminderEmail ReminderAction = "EMAIL"
+)
+
+// ReminderRelated は相対的なリマインダーの基準（TRIGGERのRELATED）
+type ReminderRelated string
+
+const (
+	RelatedStart ReminderRelated = "START"
+	RelatedEnd   ReminderRelated = "END"
+)
+
+// Reminder はイベントのリマインダー（VALARM）を表現する
+// Atが設定されていればその時刻に1回だけ、そうでなければインスタンスごとにRelatedの時刻からOffset後（負の値は前）に通知する
+type Reminder struct {
+	ID          string          `json:"id"`
+	Action      ReminderAction  `json:"action"`
+	Offset      time.Duration   `json:"offset,omitempty"`
+	Related     ReminderRelated `json:"related,omitempty"`
+	At          time.Time       `json:"at,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Recipients  []string        `json:"recipients,omitempty"` // EMAILの宛先
+}
+
+// NewReminder はインスタンスの開始のOffset後に通知する新しいリマインダーを作成する
+func NewReminder(action ReminderAction, offset time.Duration) Reminder {
+	return Reminder{
+		ID:      uuid.New().String(),
+		Action:  action,
+		Offset:  offset,
+		Related: RelatedStart,
+	}
+}
+
+// IsAbsolute はリマインダーが絶対時刻で指定されているかを返す
+func (r *Reminder) IsAbsolute() bool {
+	return !r.At.IsZero()
+}
+
+// TriggerTime はインスタンスに対してリマインダーを通知する時刻を返す
+func (r *Reminder) TriggerTime(instance *Event) time.Time {
+	switch {
+	case r.IsAbsolute():
+		return r.At
+	case r.Related == RelatedEnd:
+		return instance.DTEnd.Add(r.Offset)
+	}
+	return instance.DTStart.Add(r.Offset)
+}
+
+// FindReminder はIDが一致するリマインダーを返す（なければnil）
+func (e *Event) FindReminder(id string) *Reminder {
+	for i := range e.Reminders {
+		if e.Reminders[i].ID == id {
+			return &e.Reminders[i]
+		}
+	}
+	return nil
+}
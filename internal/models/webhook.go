@@ -0,0 +1,100 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Webhook はカレンダーのイベントの変更を通知する送信先を表現する
+type Webhook struct {
+	ID         string `json:"id"`
+	CalendarID string `json:"calendar_id"`
+	URL        string `json:"url"`
+	// Secretはペイロードの署名（HMAC-SHA256）に使う鍵
+	Secret string `json:"-"`
+	// EventTypesが空の場合はすべての種別の変更を通知する（OccurrenceStartedは含めない）
+	EventTypes []ChangeType `json:"event_types"`
+	CreatedAt  time.Time    `json:"created_at"`
+}
+
+// NewWebhook は新しいWebhookを作成する
+func NewWebhook(calendarID, url, secret string, eventTypes []ChangeType) *Webhook {
+	return &Webhook{
+		ID:         uuid.New().String(),
+		CalendarID: calendarID,
+		URL:        url,
+		Secret:     secret,
+		EventTypes: eventTypes,
+		CreatedAt:  time.Now(),
+	}
+}
+
+// Accepts はWebhookが変更の種別を通知の対象にしているかを返す
+func (w *Webhook) Accepts(t ChangeType) bool {
+	if len(w.EventTypes) == 0 {
+		return t != OccurrenceStarted && t != ReminderDue
+	}
+	for _, et := range w.EventTypes {
+		if et == t {
+			return true
+		}
+	}
+	return false
+}
+
+// DeliveryStatus はWebhookの配信の状態
+type DeliveryStatus string
+
+const (
+	// DeliveryPending は未送信または再送待ち
+	DeliveryPending DeliveryStatus = "pending"
+	// DeliveryDelivered は送信先が2xxで応答した
+	DeliveryDelivered DeliveryStatus = "delivered"
+	// DeliveryDead は再送の上限に達し、以後は送信しない（デッドレター）
+	DeliveryDead DeliveryStatus = "dead"
+)
+
+// WebhookDelivery は1件の変更を1つのWebhookに送る配信を表現する
+// ペイロードは変更を記録した時点で作成するため、再送しても内容は変わらない
+type WebhookDelivery struct {
+	ID            string         `json:"id"`
+	WebhookID     string         `json:"webhook_id"`
+	CalendarID    string         `json:"calendar_id"`
+	EventID       string         `json:"event_id"`
+	Type          ChangeType     `json:"type"`
+	Payload       []byte         `json:"payload"`
+	Status        DeliveryStatus `json:"status"`
+	Attempts      int            `json:"attempts"`
+	NextAttemptAt time.Time      `json:"next_attempt_at"`
+	LastError     string         `json:"last_error,omitempty"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+}
+
+// WebhookAttempt は配信の1回の送信結果（配信ログ）を表現する
+type WebhookAttempt struct {
+	DeliveryID  string        `json:"delivery_id"`
+	AttemptedAt time.Time     `json:"attempted_at"`
+	StatusCode  int           `json:"status_code"` // 応答がなかった場合は0
+	Error       string        `json:"error,omitempty"`
+	Duration    time.Duration `json:"duration"`
+}
+
+// WebhookPayload はWebhookで送るJSONの本文
+// Beforeは作成の場合、Afterは削除の場合にnilになる
+// オーバーライドの変更は元の繰り返しイベントの更新として送り、Before/Afterはオーバーライド自体を表す
+// インスタンスの開始（occurrence.started）ではBefore/Afterの代わりにOccurrenceを設定する
+// リマインダー（reminder.due）ではさらにReminderを設定する
+type WebhookPayload struct {
+	ID         string    `json:"id"`
+	Type       string    `json:"type"`
+	WebhookID  string    `json:"webhook_id"`
+	CalendarID string    `json:"calendar_id"`
+	EventID    string    `json:"event_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Before     *Event    `json:"before"`
+	After      *Event    `json:"after"`
+	Occurrence *Event    `json:"occurrence,omitempty"`
+	Reminder   *Reminder `json:"reminder,omitempty"`
+}
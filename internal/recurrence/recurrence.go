@@ -0,0 +1,310 @@
+package recurrence
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/teambition/rrule-go"
+
+	"github.com/recurrence-scheduler/internal/models"
+)
+
+// InstanceID は繰り返しインスタンスの識別子を生成する
+func InstanceID(eventID string, start time.Time) string {
+	return eventID + "-" + start.UTC().Format("20060102T150405Z")
+}
+
+// Location はIANAタイムゾーン名からLocationを取得する
+// 読み込めない場合はUTCを返す
+func Location(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// NewSet はイベントのRRULE・RDATE・EXDATEから繰り返し集合を構築する
+// DTSTARTはイベントのタイムゾーンに変換して設定する
+func NewSet(event *models.Event) (*rrule.Set, error) {
+	loc := Location(event.Timezone)
+	dtStart := event.DTStart.In(loc)
+
+	set := &rrule.Set{}
+	if event.RRule != "" {
+		rule, err := rrule.StrToRRule(event.RRule)
+		if err != nil {
+			return nil, err
+		}
+		set.RRule(rule)
+	} else {
+		// RRULEがない場合でもDTSTARTは最初のインスタンスになる
+		set.RDate(dtStart)
+	}
+	set.DTStart(dtStart)
+
+	for _, rdate := range event.RDates {
+		set.RDate(rdate.In(loc))
+	}
+	for _, exdate := range event.ExDates {
+		set.ExDate(exdate.In(loc))
+	}
+
+	return set, nil
+}
+
+// IsRecurring はイベントが複数のインスタンスを持ちうるかを返す
+func IsRecurring(event *models.Event) bool {
+	return event.RRule != "" || len(event.RDates) > 0
+}
+
+// Expand はイベントを期間内の具体的なインスタンスに展開する
+// インスタンスの期間が[start, end]と重なるものを開始時刻順で返す
+// 展開はイベントのタイムゾーンの壁時計時刻で行うため、DSTをまたいでも開始時刻は変わらない
+// overridesにRECURRENCE-IDが一致するオーバーライドがあれば、生成したインスタンスの代わりに返す
+func Expand(event *models.Event, overrides []*models.Event, start, end time.Time) ([]*models.Event, error) {
+	duration := event.DTEnd.Sub(event.DTStart)
+
+	if !IsRecurring(event) && len(event.ExDates) == 0 {
+		if !overlaps(event, start, end) {
+			return nil, nil
+		}
+		return []*models.Event{event}, nil
+	}
+
+	set, err := NewSet(event)
+	if err != nil {
+		return nil, err
+	}
+
+	overridden := make(map[int64]bool, len(overrides))
+	var instances []*models.Event
+	for _, override := range overrides {
+		if isExcluded(event, override.RecurrenceID) {
+			continue
+		}
+		overridden[override.RecurrenceID.Unix()] = true
+		if overlaps(override, start, end) {
+			instances = append(instances, override)
+		}
+	}
+
+	// 期間開始前に始まり期間内まで続くインスタンスも含める
+	for _, instanceStart := range set.Between(start.Add(-duration), end, true) {
+		if overridden[instanceStart.Unix()] {
+			continue
+		}
+		instances = append(instances, newInstance(event, instanceStart))
+	}
+
+	sortByStart(instances)
+	return instances, nil
+}
+
+// newInstance は繰り返しイベントのinstanceStartに開始するインスタンスを生成する
+func newInstance(event *models.Event, instanceStart time.Time) *models.Event {
+	return &models.Event{
+		ID:               InstanceID(event.ID, instanceStart),
+		UID:              event.UID,
+		CalendarID:       event.CalendarID,
+		Title:            event.Title,
+		Description:      event.Description,
+		DTStart:          instanceStart,
+		DTEnd:            instanceEnd(event, instanceStart),
+		RRule:            "", // インスタンスにはRRULEを持たない
+		Timezone:         event.Timezone,
+		AllDay:           event.AllDay,
+		RecurringEventID: event.ID,
+		RecurrenceID:     instanceStart,
+		Reminders:        slices.Clone(event.Reminders),
+		CreatedAt:        event.CreatedAt,
+		UpdatedAt:        event.UpdatedAt,
+	}
+}
+
+// Next はafterより後に開始する最初のインスタンスを返す（なければnil）
+// 次のインスタンスはrrule-goのAfterで求め、オーバーライドで移動したインスタンスは移動後の開始時刻で比較する
+func Next(event *models.Event, overrides []*models.Event, after time.Time) (*models.Event, error) {
+	if !IsRecurring(event) && len(event.ExDates) == 0 {
+		if !event.DTStart.After(after) {
+			return nil, nil
+		}
+		return event, nil
+	}
+
+	set, err := NewSet(event)
+	if err != nil {
+		return nil, err
+	}
+
+	var next *models.Event
+	overridden := make(map[int64]bool, len(overrides))
+	for _, override := range overrides {
+		if isExcluded(event, override.RecurrenceID) {
+			continue
+		}
+		overridden[override.RecurrenceID.Unix()] = true
+		if override.DTStart.After(after) && (next == nil || override.DTStart.Before(next.DTStart)) {
+			next = override
+		}
+	}
+
+	// オーバーライドされたインスタンスは飛ばす（飛ばす回数はオーバーライドの数を超えない）
+	t := after
+	for i := 0; i <= len(overrides); i++ {
+		instanceStart := set.After(t, false)
+		if instanceStart.IsZero() {
+			break
+		}
+		if overridden[instanceStart.Unix()] {
+			t = instanceStart
+			continue
+		}
+		if next == nil || instanceStart.Before(next.DTStart) {
+			next = newInstance(event, instanceStart)
+		}
+		break
+	}
+
+	return next, nil
+}
+
+// NewOverride は繰り返しイベントのインスタンスを置き換えるオーバーライドを作成する
+// IDはインスタンスIDと同じにするため、生成されたインスタンスのIDでそのまま参照できる
+func NewOverride(event *models.Event, recurrenceID time.Time) *models.Event {
+	now := time.Now()
+	return &models.Event{
+		ID:               InstanceID(event.ID, recurrenceID),
+		UID:              event.UID,
+		CalendarID:       event.CalendarID,
+		Title:            event.Title,
+		Description:      event.Description,
+		DTStart:          recurrenceID,
+		DTEnd:            instanceEnd(event, recurrenceID),
+		Timezone:         event.Timezone,
+		AllDay:           event.AllDay,
+		RecurringEventID: event.ID,
+		RecurrenceID:     recurrenceID,
+		Reminders:        slices.Clone(event.Reminders),
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+}
+
+// instanceEnd はinstanceStartに開始するインスタンスの終了時刻を返す
+// 終日イベントは夏時間の切り替えをまたいでも0時に終わるよう、時間ではなく日数で足す
+func instanceEnd(event *models.Event, instanceStart time.Time) time.Time {
+	if event.AllDay {
+		days := int(event.DTEnd.Sub(event.DTStart).Round(24*time.Hour) / (24 * time.Hour))
+		return instanceStart.In(Location(event.Timezone)).AddDate(0, 0, days)
+	}
+	return instanceStart.Add(event.DTEnd.Sub(event.DTStart))
+}
+
+// overlaps はイベントの期間が[start, end]と重なるかを返す
+func overlaps(event *models.Event, start, end time.Time) bool {
+	return !event.DTStart.After(end) && !event.DTEnd.Before(start)
+}
+
+// isExcluded はtがイベントのEXDATEに含まれるかを返す
+func isExcluded(event *models.Event, t time.Time) bool {
+	for _, exDate := range event.ExDates {
+		if exDate.Equal(t) {
+			return true
+		}
+	}
+	return false
+}
+
+func sortByStart(instances []*models.Event) {
+	sort.SliceStable(instances, func(i, j int) bool {
+		return instances[i].DTStart.Before(instances[j].DTStart)
+	})
+}
+
+// IsOccurrence はtがEXDATEを除いたイベントのインスタンス開始時刻かを返す
+func IsOccurrence(event *models.Event, t time.Time) (bool, error) {
+	set, err := NewSet(event)
+	if err != nil {
+		return false, err
+	}
+	for _, occurrence := range set.Between(t, t, true) {
+		if occurrence.Equal(t) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// SplitRRule は繰り返しルールをfromの直前で打ち切ったルールと、fromから始まる残りのルールに分割する
+// COUNTを持つルールは、打ち切った側で消費した回数を残りのルールから差し引く
+// COUNTやUNTILでfrom以降のインスタンスが残っていない場合はエラーを返す
+func SplitRRule(event *models.Event, from time.Time) (before, after string, err error) {
+	option, err := rrule.StrToROption(event.RRule)
+	if err != nil {
+		return "", "", err
+	}
+
+	rule, err := rrule.StrToRRule(event.RRule)
+	if err != nil {
+		return "", "", err
+	}
+	rule.DTStart(event.DTStart.In(Location(event.Timezone)))
+
+	consumed := 0
+	next := rule.Iterator()
+	for t, ok := next(); ok && t.Before(from); t, ok = next() {
+		consumed++
+	}
+
+	if !option.Until.IsZero() && option.Until.Before(from) {
+		return "", "", fmt.Errorf("no occurrences remain after %s", from.Format(time.RFC3339))
+	}
+
+	afterOption := *option
+	if option.Count > 0 {
+		afterOption.Count = option.Count - consumed
+		if afterOption.Count <= 0 {
+			return "", "", fmt.Errorf("no occurrences remain after %s", from.Format(time.RFC3339))
+		}
+	}
+
+	beforeOption := *option
+	beforeOption.Count = 0
+	beforeOption.Until = from.Add(-time.Second).UTC()
+
+	return beforeOption.RRuleString(), afterOption.RRuleString(), nil
+}
+
+// ExpandAll は複数のイベントを展開し、すべてのインスタンスを開始時刻順に並べて返す
+// overridesは各イベントのオーバーライドをまとめて渡す
+func ExpandAll(events, overrides []*models.Event, start, end time.Time) ([]*models.Event, error) {
+	byMaster := make(map[string][]*models.Event)
+	for _, override := range overrides {
+		byMaster[override.RecurringEventID] = append(byMaster[override.RecurringEventID], override)
+	}
+
+	var instances []*models.Event
+	for _, event := range events {
+		expanded, err := Expand(event, byMaster[event.ID], start, end)
+		if err != nil {
+			return nil, err
+		}
+		instances = append(instances, expanded...)
+		delete(byMaster, event.ID)
+	}
+
+	// 元のイベントが期間外でも、期間内に移動したオーバーライドは含める
+	for _, rest := range byMaster {
+		for _, override := range rest {
+			if overlaps(override, start, end) {
+				instances = append(instances, override)
+			}
+		}
+	}
+
+	sortByStart(instances)
+	return instances, nil
+}
@@ -0,0 +1,398 @@
+package recurrence
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/recurrence-scheduler/internal/models"
+)
+
+// mustLoad はテストに使うタイムゾーンを読み込む（Locationと違いUTCに置き換えない）
+func mustLoad(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("time zone %s is not available: %v", name, err)
+	}
+	return loc
+}
+
+// localTimes はインスタンスの開始・終了時刻をlocの壁時計時刻で返す
+func localTimes(instances []*models.Event, loc *time.Location) (starts, ends []string) {
+	for _, instance := range instances {
+		starts = append(starts, instance.DTStart.In(loc).Format("2006-01-02 15:04 MST"))
+		ends = append(ends, instance.DTEnd.In(loc).Format("2006-01-02 15:04 MST"))
+	}
+	return starts, ends
+}
+
+func TestExpandAcrossDST(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+	local := func(year int, month time.Month, day, hour int) time.Time {
+		return time.Date(year, month, day, hour, 0, 0, 0, ny)
+	}
+
+	tests := []struct {
+		name       string
+		dtStart    time.Time
+		dtEnd      time.Time
+		rrule      string
+		allDay     bool
+		start, end time.Time
+		wantStarts []string
+		wantEnds   []string
+	}{
+		{
+			// 2025-03-09に夏時間が始まる
+			name:       "weekly 09:00 across the March change",
+			dtStart:    local(2025, 3, 3, 9),
+			dtEnd:      local(2025, 3, 3, 10),
+			rrule:      "FREQ=WEEKLY;COUNT=3",
+			start:      local(2025, 3, 1, 0),
+			end:        local(2025, 3, 31, 0),
+			wantStarts: []string{"2025-03-03 09:00 EST", "2025-03-10 09:00 EDT", "2025-03-17 09:00 EDT"},
+			wantEnds:   []string{"2025-03-03 10:00 EST", "2025-03-10 10:00 EDT", "2025-03-17 10:00 EDT"},
+		},
+		{
+			// 2025-11-02に夏時間が終わる
+			name:       "weekly 09:00 across the November change",
+			dtStart:    local(2025, 10, 27, 9),
+			dtEnd:      local(2025, 10, 27, 10),
+			rrule:      "FREQ=WEEKLY;COUNT=3",
+			start:      local(2025, 10, 1, 0),
+			end:        local(2025, 11, 30, 0),
+			wantStarts: []string{"2025-10-27 09:00 EDT", "2025-11-03 09:00 EST", "2025-11-10 09:00 EST"},
+			wantEnds:   []string{"2025-10-27 10:00 EDT", "2025-11-03 10:00 EST", "2025-11-10 10:00 EST"},
+		},
+		{
+			// 3月9日は23時間しかないが、終日イベントは翌日の0時に終わる
+			name:       "all-day across the March change",
+			dtStart:    local(2025, 3, 8, 0),
+			dtEnd:      local(2025, 3, 9, 0),
+			rrule:      "FREQ=DAILY;COUNT=3",
+			allDay:     true,
+			start:      local(2025, 3, 1, 0),
+			end:        local(2025, 3, 31, 0),
+			wantStarts: []string{"2025-03-08 00:00 EST", "2025-03-09 00:00 EST", "2025-03-10 00:00 EDT"},
+			wantEnds:   []string{"2025-03-09 00:00 EST", "2025-03-10 00:00 EDT", "2025-03-11 00:00 EDT"},
+		},
+		{
+			// 11月2日は25時間ある
+			name:       "all-day across the November change",
+			dtStart:    local(2025, 11, 1, 0),
+			dtEnd:      local(2025, 11, 2, 0),
+			rrule:      "FREQ=DAILY;COUNT=3",
+			allDay:     true,
+			start:      local(2025, 10, 1, 0),
+			end:        local(2025, 11, 30, 0),
+			wantStarts: []string{"2025-11-01 00:00 EDT", "2025-11-02 00:00 EDT", "2025-11-03 00:00 EST"},
+			wantEnds:   []string{"2025-11-02 00:00 EDT", "2025-11-03 00:00 EST", "2025-11-04 00:00 EST"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// 保存時と同じくUTCで渡し、展開がタイムゾーンの壁時計時刻で行われることを確認する
+			event := models.NewEvent("cal", "event", "", tt.dtStart.UTC(), tt.dtEnd.UTC(), tt.rrule, "America/New_York")
+			event.AllDay = tt.allDay
+
+			instances, err := Expand(event, nil, tt.start, tt.end)
+			if err != nil {
+				t.Fatal(err)
+			}
+			starts, ends := localTimes(instances, ny)
+			if !slices.Equal(starts, tt.wantStarts) {
+				t.Errorf("starts = %q, want %q", starts, tt.wantStarts)
+			}
+			if !slices.Equal(ends, tt.wantEnds) {
+				t.Errorf("ends = %q, want %q", ends, tt.wantEnds)
+			}
+		})
+	}
+}
+
+func TestExpandExDatesAndRDates(t *testing.T) {
+	day := func(d, hour int) time.Time { return time.Date(2025, 1, d, hour, 0, 0, 0, time.UTC) }
+	window := func(event *models.Event) ([]*models.Event, error) {
+		return Expand(event, nil, day(1, 0), day(31, 0))
+	}
+
+	tests := []struct {
+		name    string
+		rrule   string
+		exDates []time.Time
+		rDates  []time.Time
+		want    []time.Time
+	}{
+		{
+			name:    "EXDATE removes an instance",
+			rrule:   "FREQ=DAILY;COUNT=4",
+			exDates: []time.Time{day(7, 9)},
+			want:    []time.Time{day(6, 9), day(8, 9), day(9, 9)},
+		},
+		{
+			name:    "EXDATE that is not an instance is ignored",
+			rrule:   "FREQ=DAILY;COUNT=2",
+			exDates: []time.Time{day(7, 10)},
+			want:    []time.Time{day(6, 9), day(7, 9)},
+		},
+		{
+			name:   "RDATE adds an instance in order",
+			rrule:  "FREQ=WEEKLY;COUNT=2",
+			rDates: []time.Time{day(8, 15)},
+			want:   []time.Time{day(6, 9), day(8, 15), day(13, 9)},
+		},
+		{
+			name:   "RDATE that repeats an instance is not duplicated",
+			rrule:  "FREQ=DAILY;COUNT=2",
+			rDates: []time.Time{day(7, 9)},
+			want:   []time.Time{day(6, 9), day(7, 9)},
+		},
+		{
+			name:    "EXDATE wins over RDATE",
+			rrule:   "FREQ=DAILY;COUNT=2",
+			rDates:  []time.Time{day(10, 9)},
+			exDates: []time.Time{day(10, 9)},
+			want:    []time.Time{day(6, 9), day(7, 9)},
+		},
+		{
+			name:   "RDATE without RRULE keeps DTSTART",
+			rDates: []time.Time{day(20, 9)},
+			want:   []time.Time{day(6, 9), day(20, 9)},
+		},
+		{
+			name:    "EXDATE on DTSTART of a single event",
+			exDates: []time.Time{day(6, 9)},
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := models.NewEvent("cal", "event", "", day(6, 9), day(6, 10), tt.rrule, "UTC")
+			event.ExDates = tt.exDates
+			event.RDates = tt.rDates
+
+			instances, err := window(event)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []time.Time
+			for _, instance := range instances {
+				got = append(got, instance.DTStart)
+				if d := instance.DTEnd.Sub(instance.DTStart); d != time.Hour {
+					t.Errorf("instance %s lasts %v, want 1h", instance.ID, d)
+				}
+			}
+			if !slices.EqualFunc(got, tt.want, time.Time.Equal) {
+				t.Errorf("starts = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// newTestOverride はeventのrecurrenceIDのインスタンスをdtStartに移動したオーバーライドを作成する
+func newTestOverride(event *models.Event, recurrenceID, dtStart time.Time) *models.Event {
+	override := NewOverride(event, recurrenceID)
+	override.DTStart, override.DTEnd = dtStart, dtStart.Add(event.DTEnd.Sub(event.DTStart))
+	override.Title = "moved"
+	return override
+}
+
+func TestExpandOverrides(t *testing.T) {
+	day := func(d, hour int) time.Time { return time.Date(2025, 1, d, hour, 0, 0, 0, time.UTC) }
+	event := models.NewEvent("cal", "daily", "", day(6, 9), day(6, 10), "FREQ=DAILY;COUNT=5", "UTC")
+
+	tests := []struct {
+		name       string
+		exDates    []time.Time
+		overrides  []*models.Event
+		start, end time.Time
+		want       []time.Time
+		wantMoved  int
+	}{
+		{
+			name:      "override replaces its instance",
+			overrides: []*models.Event{newTestOverride(event, day(7, 9), day(7, 15))},
+			start:     day(6, 0),
+			end:       day(8, 0),
+			want:      []time.Time{day(6, 9), day(7, 15)},
+			wantMoved: 1,
+		},
+		{
+			name:      "override moved out of the window removes the instance",
+			overrides: []*models.Event{newTestOverride(event, day(7, 9), day(20, 9))},
+			start:     day(6, 0),
+			end:       day(8, 0),
+			want:      []time.Time{day(6, 9)},
+		},
+		{
+			name:      "override moved into the window is returned",
+			overrides: []*models.Event{newTestOverride(event, day(10, 9), day(6, 15))},
+			start:     day(6, 0),
+			end:       day(7, 0),
+			want:      []time.Time{day(6, 9), day(6, 15)},
+			wantMoved: 1,
+		},
+		{
+			name:      "override of an excluded instance is ignored",
+			exDates:   []time.Time{day(7, 9)},
+			overrides: []*models.Event{newTestOverride(event, day(7, 9), day(7, 15))},
+			start:     day(6, 0),
+			end:       day(8, 0),
+			want:      []time.Time{day(6, 9)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := *event
+			e.ExDates = tt.exDates
+			instances, err := Expand(&e, tt.overrides, tt.start, tt.end)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []time.Time
+			moved := 0
+			for _, instance := range instances {
+				got = append(got, instance.DTStart)
+				if instance.Title == "moved" {
+					moved++
+				}
+			}
+			if !slices.EqualFunc(got, tt.want, time.Time.Equal) {
+				t.Errorf("starts = %v, want %v", got, tt.want)
+			}
+			if moved != tt.wantMoved {
+				t.Errorf("got %d overrides, want %d", moved, tt.wantMoved)
+			}
+		})
+	}
+}
+
+func TestNextOverrides(t *testing.T) {
+	day := func(d, hour int) time.Time { return time.Date(2025, 1, d, hour, 0, 0, 0, time.UTC) }
+	event := models.NewEvent("cal", "daily", "", day(6, 9), day(6, 10), "FREQ=DAILY;COUNT=3", "UTC")
+
+	tests := []struct {
+		name      string
+		overrides []*models.Event
+		after     time.Time
+		want      time.Time
+		wantMoved bool
+	}{
+		{name: "next instance", after: day(6, 9), want: day(7, 9)},
+		{
+			name:      "overridden instance is skipped",
+			overrides: []*models.Event{newTestOverride(event, day(7, 9), day(20, 9))},
+			after:     day(6, 9),
+			want:      day(8, 9),
+		},
+		{
+			name:      "override moved earlier comes first",
+			overrides: []*models.Event{newTestOverride(event, day(8, 9), day(6, 12))},
+			after:     day(6, 9),
+			want:      day(6, 12),
+			wantMoved: true,
+		},
+		{
+			name:      "override after the last instance",
+			overrides: []*models.Event{newTestOverride(event, day(8, 9), day(20, 9))},
+			after:     day(7, 9),
+			want:      day(20, 9),
+			wantMoved: true,
+		},
+		{name: "series has ended", after: day(8, 9)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next, err := Next(event, tt.overrides, tt.after)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.want.IsZero() {
+				if next != nil {
+					t.Errorf("next = %v, want none", next.DTStart)
+				}
+				return
+			}
+			if next == nil {
+				t.Fatalf("next = none, want %v", tt.want)
+			}
+			if !next.DTStart.Equal(tt.want) {
+				t.Errorf("next = %v, want %v", next.DTStart, tt.want)
+			}
+			if moved := next.Title == "moved"; moved != tt.wantMoved {
+				t.Errorf("next is an override = %v, want %v", moved, tt.wantMoved)
+			}
+		})
+	}
+}
+
+func TestSplitRRule(t *testing.T) {
+	day := func(d, hour int) time.Time { return time.Date(2025, 1, d, hour, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name       string
+		rrule      string
+		from       time.Time
+		wantBefore string
+		wantAfter  string
+		wantErr    bool
+	}{
+		{
+			name:       "from on an occurrence starts the new series",
+			rrule:      "FREQ=DAILY",
+			from:       day(8, 9),
+			wantBefore: "FREQ=DAILY;UNTIL=20250108T085959Z",
+			wantAfter:  "FREQ=DAILY",
+		},
+		{
+			name:       "COUNT is shared between both parts",
+			rrule:      "FREQ=DAILY;COUNT=5",
+			from:       day(8, 9),
+			wantBefore: "FREQ=DAILY;UNTIL=20250108T085959Z",
+			wantAfter:  "FREQ=DAILY;COUNT=3",
+		},
+		{
+			name:       "from between occurrences",
+			rrule:      "FREQ=DAILY;COUNT=5",
+			from:       day(8, 12),
+			wantBefore: "FREQ=DAILY;UNTIL=20250108T115959Z",
+			wantAfter:  "FREQ=DAILY;COUNT=2",
+		},
+		{
+			name:       "UNTIL after from is kept for the new series",
+			rrule:      "FREQ=DAILY;UNTIL=20250110T090000Z",
+			from:       day(8, 9),
+			wantBefore: "FREQ=DAILY;UNTIL=20250108T085959Z",
+			wantAfter:  "FREQ=DAILY;UNTIL=20250110T090000Z",
+		},
+		{name: "COUNT exhausted", rrule: "FREQ=DAILY;COUNT=2", from: day(8, 9), wantErr: true},
+		{name: "UNTIL already before from", rrule: "FREQ=DAILY;UNTIL=20250107T090000Z", from: day(8, 9), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := models.NewEvent("cal", "daily", "", day(6, 9), day(6, 10), tt.rrule, "UTC")
+			before, after, err := SplitRRule(event, tt.from)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("SplitRRule = %q, %q, want an error", before, after)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if before != tt.wantBefore {
+				t.Errorf("before = %q, want %q", before, tt.wantBefore)
+			}
+			if after != tt.wantAfter {
+				t.Errorf("after = %q, want %q", after, tt.wantAfter)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Rule はRFC 5545のRECUR規則の各要素
+type Rule struct {
+	Freq       string
+	Interval   int32
+	Count      int32
+	Until      string // UNTILの値（書式の検証のため文字列のまま保持する）
+	BySecond   []int32
+	ByMinute   []int32
+	ByHour     []int32
+	ByDay      []string // 序数付きを含む曜日（MO、2FR、-1SUなど）
+	ByMonthDay []int32
+	ByYearDay  []int32
+	ByWeekNo   []int32
+	ByMonth    []int32
+	BySetPos   []int32
+	Wkst       string
+}
+
+// weekdayPattern はBYDAYの要素（序数付きの曜日）にマッチする
+var weekdayPattern = regexp.MustCompile(`^([+-]?[1-9][0-9]?)?(MO|TU|WE|TH|FR|SA|SU)$`)
+
+// ParseRule はRFC 5545形式のRRULE文字列（"RRULE:"の接頭辞は省略可）を解析する
+// 各要素の値の範囲も検証する。RECUR規則のすべての要素を保持するため、Stringとの往復で情報は失われない
+func ParseRule(s string) (*Rule, error) {
+	rule := &Rule{}
+	seen := make(map[string]bool)
+
+	for _, part := range strings.Split(strings.TrimPrefix(s, "RRULE:"), ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("malformed rule part %q", part)
+		}
+		name = strings.ToUpper(name)
+		if seen[name] {
+			return nil, fmt.Errorf("%s specified more than once", name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			rule.Freq = strings.ToUpper(value)
+			switch rule.Freq {
+			case "SECONDLY", "MINUTELY", "HOURLY", "DAILY", "WEEKLY", "MONTHLY", "YEARLY":
+			default:
+				err = fmt.Errorf("unknown FREQ %q", value)
+			}
+		case "INTERVAL":
+			rule.Interval, err = parseRuleInt(name, value, 1, math.MaxInt32, false)
+		case "COUNT":
+			rule.Count, err = parseRuleInt(name, value, 1, math.MaxInt32, false)
+		case "UNTIL":
+			rule.Until = value
+		case "BYSECOND":
+			rule.BySecond, err = parseRuleInts(name, value, 0, 60, false)
+		case "BYMINUTE":
+			rule.ByMinute, err = parseRuleInts(name, value, 0, 59, false)
+		case "BYHOUR":
+			rule.ByHour, err = parseRuleInts(name, value, 0, 23, false)
+		case "BYDAY":
+			for _, d := range strings.Split(value, ",") {
+				d = strings.ToUpper(d)
+				if !weekdayPattern.MatchString(d) {
+					return nil, fmt.Errorf("invalid BYDAY value %q", d)
+				}
+				rule.ByDay = append(rule.ByDay, d)
+			}
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseRuleInts(name, value, 1, 31, true)
+		case "BYYEARDAY":
+			rule.ByYearDay, err = parseRuleInts(name, value, 1, 366, true)
+		case "BYWEEKNO":
+			rule.ByWeekNo, err = parseRuleInts(name, value, 1, 53, true)
+		case "BYMONTH":
+			rule.ByMonth, err = parseRuleInts(name, value, 1, 12, false)
+		case "BYSETPOS":
+			rule.BySetPos, err = parseRuleInts(name, value, 1, 366, true)
+		case "WKST":
+			rule.Wkst = strings.ToUpper(value)
+			if !weekdayPattern.MatchString(rule.Wkst) || len(rule.Wkst) != 2 {
+				err = fmt.Errorf("invalid WKST %q", value)
+			}
+		default:
+			err = fmt.Errorf("unsupported rule part %s", name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if rule.Freq == "" {
+		return nil, errors.New("FREQ is required")
+	}
+
+	return rule, nil
+}
+
+// parseRuleInts はカンマ区切りの整数リストを解析する
+// signedがtrueの場合は負の値（末尾からの位置）も許可する
+func parseRuleInts(name, value string, min, max int64, signed bool) ([]int32, error) {
+	var is []int32
+	for _, v := range strings.Split(value, ",") {
+		i, err := parseRuleInt(name, v, min, max, signed)
+		if err != nil {
+			return nil, err
+		}
+		is = append(is, i)
+	}
+	return is, nil
+}
+
+func parseRuleInt(name, value string, min, max int64, signed bool) (int32, error) {
+	i, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s value %q", name, value)
+	}
+	abs := i
+	if signed && i < 0 {
+		abs = -i
+	}
+	if abs < min || abs > max {
+		return 0, fmt.Errorf("%s value %d out of range", name, i)
+	}
+	return int32(i), nil
+}
+
+// String はルールをRFC 5545形式のRRULE文字列（"RRULE:"を含まない）にする
+// 要素はFREQ、INTERVAL、COUNT、UNTIL、BYxxx、WKSTの順に並べる
+func (r *Rule) String() string {
+	var parts []string
+
+	if r.Freq != "" {
+		parts = append(parts, "FREQ="+strings.ToUpper(r.Freq))
+	}
+	if r.Interval > 0 {
+		parts = append(parts, "INTERVAL="+strconv.FormatInt(int64(r.Interval), 10))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.FormatInt(int64(r.Count), 10))
+	}
+	if r.Until != "" {
+		parts = append(parts, "UNTIL="+r.Until)
+	}
+
+	addInts := func(name string, values []int32) {
+		if len(values) > 0 {
+			parts = append(parts, name+"="+formatRuleInts(values))
+		}
+	}
+	addInts("BYSECOND", r.BySecond)
+	addInts("BYMINUTE", r.ByMinute)
+	addInts("BYHOUR", r.ByHour)
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, d := range r.ByDay {
+			days[i] = strings.ToUpper(d)
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	addInts("BYMONTHDAY", r.ByMonthDay)
+	addInts("BYYEARDAY", r.ByYearDay)
+	addInts("BYWEEKNO", r.ByWeekNo)
+	addInts("BYMONTH", r.ByMonth)
+	addInts("BYSETPOS", r.BySetPos)
+
+	if r.Wkst != "" {
+		parts = append(parts, "WKST="+strings.ToUpper(r.Wkst))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+func formatRuleInts(is []int32) string {
+	parts := make([]string, len(is))
+	for i, v := range is {
+		parts[i] = strconv.FormatInt(int64(v), 10)
+	}
+	return strings.Join(parts, ",")
+}
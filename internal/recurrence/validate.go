@@ -0,0 +1,104 @@
+package recurrence
+
+import (
+	"time"
+
+	"github.com/teambition/rrule-go"
+)
+
+// UntilLayout はDTSTARTが日時の場合にUNTILが従うべきUTC日時の形式
+const UntilLayout = "20060102T150405Z"
+
+// Violation は繰り返しルールの制約違反
+// FieldはAPIのフィールド名（ルール全体の場合は"rrule"、要素の場合は"rrule.until"など）
+type Violation struct {
+	Field       string
+	Description string
+}
+
+// Validate は繰り返しルールをDTSTARTと合わせて検証し、制約違反を返す（問題がなければnil）
+// APIでもCalDAVでも、保存するルールはすべてこの検証を通す
+func Validate(s string, dtStart time.Time) []Violation {
+	if s == "" {
+		return nil
+	}
+
+	rule, err := ParseRule(s)
+	if err != nil {
+		return []Violation{{"rrule", err.Error()}}
+	}
+
+	if violations := ruleViolations(rule, dtStart); len(violations) > 0 {
+		return violations
+	}
+
+	// 上記で検出できない組み合わせはrrule-goでの解析結果に委ねる
+	if _, err := rrule.StrToRRule(s); err != nil {
+		return []Violation{{"rrule", err.Error()}}
+	}
+
+	return nil
+}
+
+// ruleViolations はRFC 5545で定められたRECUR規則の制約違反を列挙する
+func ruleViolations(rule *Rule, dtStart time.Time) []Violation {
+	var violations []Violation
+
+	if rule.Count > 0 && rule.Until != "" {
+		violations = append(violations, Violation{"rrule.count", "COUNT and UNTIL must not both be specified"})
+	}
+
+	if rule.Until != "" {
+		// DTSTARTは常に日時として保存しているため、UNTILもUTCの日時でなければならない
+		until, err := time.Parse(UntilLayout, rule.Until)
+		switch {
+		case err != nil:
+			violations = append(violations, Violation{"rrule.until",
+				"UNTIL must be a UTC date-time (YYYYMMDDTHHMMSSZ) because DTSTART is a date-time"})
+		case until.Before(dtStart):
+			violations = append(violations, Violation{"rrule.until", "UNTIL must not be before DTSTART"})
+		}
+	}
+
+	if len(rule.ByWeekNo) > 0 && rule.Freq != "YEARLY" {
+		violations = append(violations, Violation{"rrule.byweekno", "BYWEEKNO is only valid with FREQ=YEARLY"})
+	}
+
+	if len(rule.ByYearDay) > 0 && (rule.Freq == "DAILY" || rule.Freq == "WEEKLY" || rule.Freq == "MONTHLY") {
+		violations = append(violations, Violation{"rrule.byyearday", "BYYEARDAY is not valid with FREQ=" + rule.Freq})
+	}
+
+	if len(rule.ByMonthDay) > 0 && rule.Freq == "WEEKLY" {
+		violations = append(violations, Violation{"rrule.bymonthday", "BYMONTHDAY is not valid with FREQ=WEEKLY"})
+	}
+
+	for _, day := range rule.ByDay {
+		if len(day) == 2 {
+			continue
+		}
+		// 序数付きの曜日はMONTHLYとYEARLYでのみ使用でき、YEARLYではBYWEEKNOと併用できない
+		if rule.Freq != "MONTHLY" && rule.Freq != "YEARLY" {
+			violations = append(violations, Violation{"rrule.byday",
+				"numeric BYDAY values (" + day + ") are only valid with FREQ=MONTHLY or FREQ=YEARLY"})
+			break
+		}
+		if rule.Freq == "YEARLY" && len(rule.ByWeekNo) > 0 {
+			violations = append(violations, Violation{"rrule.byday",
+				"numeric BYDAY values (" + day + ") must not be combined with BYWEEKNO"})
+			break
+		}
+	}
+
+	if len(rule.BySetPos) > 0 && !hasByRule(rule) {
+		violations = append(violations, Violation{"rrule.bysetpos", "BYSETPOS requires another BYxxx rule part"})
+	}
+
+	return violations
+}
+
+// hasByRule はBYSETPOS以外のBYxxx要素が指定されているかを返す
+func hasByRule(rule *Rule) bool {
+	return len(rule.BySecond) > 0 || len(rule.ByMinute) > 0 || len(rule.ByHour) > 0 ||
+		len(rule.ByDay) > 0 || len(rule.ByMonthDay) > 0 || len(rule.ByYearDay) > 0 ||
+		len(rule.ByWeekNo) > 0 || len(rule.ByMonth) > 0
+}
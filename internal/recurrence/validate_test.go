@@ -0,0 +1,51 @@
+package recurrence
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestValidate(t *testing.T) {
+	dtStart := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		rule       string
+		wantFields []string
+	}{
+		{rule: ""},
+		{rule: "FREQ=DAILY;COUNT=10"},
+		{rule: "FREQ=DAILY;UNTIL=20250106T090000Z"},
+		{rule: "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1"},
+		{rule: "FREQ=YEARLY;BYWEEKNO=20;BYDAY=MO"},
+		{rule: "FREQ=DAILY;COUNT=3;UNTIL=20250110T090000Z", wantFields: []string{"rrule.count"}},
+		{rule: "FREQ=DAILY;UNTIL=20250110", wantFields: []string{"rrule.until"}},
+		{rule: "FREQ=DAILY;UNTIL=20250110T090000", wantFields: []string{"rrule.until"}},
+		{rule: "FREQ=DAILY;UNTIL=20250105T090000Z", wantFields: []string{"rrule.until"}},
+		{rule: "FREQ=MONTHLY;BYWEEKNO=1", wantFields: []string{"rrule.byweekno"}},
+		{rule: "FREQ=MONTHLY;BYYEARDAY=100", wantFields: []string{"rrule.byyearday"}},
+		{rule: "FREQ=WEEKLY;BYMONTHDAY=1", wantFields: []string{"rrule.bymonthday"}},
+		{rule: "FREQ=WEEKLY;BYDAY=1MO", wantFields: []string{"rrule.byday"}},
+		{rule: "FREQ=YEARLY;BYWEEKNO=1;BYDAY=1MO", wantFields: []string{"rrule.byday"}},
+		{rule: "FREQ=MONTHLY;BYSETPOS=1", wantFields: []string{"rrule.bysetpos"}},
+		{rule: "FREQ=DAILY;BYHOUR=25", wantFields: []string{"rrule"}},
+		{rule: "COUNT=3", wantFields: []string{"rrule"}},
+		// 複数の違反はまとめて返す
+		{rule: "FREQ=WEEKLY;COUNT=3;UNTIL=20250110T090000Z;BYMONTHDAY=1", wantFields: []string{"rrule.count", "rrule.bymonthday"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			var fields []string
+			for _, v := range Validate(tt.rule, dtStart) {
+				fields = append(fields, v.Field)
+				if v.Description == "" {
+					t.Errorf("violation of %s has no description", v.Field)
+				}
+			}
+			if !slices.Equal(fields, tt.wantFields) {
+				t.Errorf("fields = %q, want %q", fields, tt.wantFields)
+			}
+		})
+	}
+}
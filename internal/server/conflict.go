@@ -0,0 +1,169 @@
+package server
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/recurrence-scheduler/internal/models"
+	"github.com/recurrence-scheduler/internal/recurrence"
+	pb "github.com/recurrence-scheduler/proto/scheduler/v1"
+)
+
+const (
+	// defaultConflictHorizonDays と maxConflictHorizonDays は繰り返しイベントを展開して重なりを調べる日数の既定値と上限
+	defaultConflictHorizonDays = 365
+	maxConflictHorizonDays     = 5 * 365
+	// maxReportedConflicts は報告する重なりの件数の上限
+	maxReportedConflicts = 100
+)
+
+// conflictCheck はCreateEventとUpdateEventで共通の重なりの確認方法の指定
+type conflictCheck struct {
+	policy      pb.ConflictPolicy
+	calendarIDs []string
+	horizonDays int32
+}
+
+// checkConflicts はイベントのインスタンスと、同じカレンダーおよびcalendarIDsのカレンダーにある
+// 既存のインスタンスとの重なりを調べる
+// 繰り返しイベントは開始と現在時刻の遅い方からhorizonDays日分を展開する。イベント自身（更新の場合）のインスタンスは比較しない
+// REJECTの場合は重なりがあればFailedPreconditionを返し、WARNの場合は重なりを返す
+func (s *Server) checkConflicts(event *models.Event, check conflictCheck) ([]*pb.Conflict, error) {
+	switch check.policy {
+	case pb.ConflictPolicy_CONFLICT_POLICY_UNSPECIFIED, pb.ConflictPolicy_CONFLICT_POLICY_IGNORE:
+		return nil, nil
+	case pb.ConflictPolicy_CONFLICT_POLICY_WARN, pb.ConflictPolicy_CONFLICT_POLICY_REJECT:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported conflict_policy: %v", check.policy)
+	}
+
+	horizonDays := int(check.horizonDays)
+	switch {
+	case horizonDays == 0:
+		horizonDays = defaultConflictHorizonDays
+	case horizonDays < 0 || horizonDays > maxConflictHorizonDays:
+		return nil, status.Errorf(codes.InvalidArgument, "conflict_horizon_days must be between 1 and %d", maxConflictHorizonDays)
+	}
+	if len(check.calendarIDs) > maxFreeBusyCalendars {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d conflict_calendar_ids can be specified", maxFreeBusyCalendars)
+	}
+
+	// 過去に始まった繰り返しイベントでも、これからのインスタンスを確認する
+	start := event.DTStart
+	if now := time.Now(); now.After(start) {
+		start = now
+	}
+	end := start.AddDate(0, 0, horizonDays)
+	if event.DTEnd.After(end) {
+		end = event.DTEnd
+	}
+
+	var overrides []*models.Event
+	if !event.IsOverride() {
+		var err error
+		if overrides, err = s.storage.ListOverrides(event.ID); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	instances, err := recurrence.Expand(event, overrides, start, end)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	calendarIDs := []string{event.CalendarID}
+	for _, id := range check.calendarIDs {
+		if containsString(calendarIDs, id) {
+			continue
+		}
+		if _, err := s.storage.GetCalendar(id); err != nil {
+			return nil, status.Errorf(codes.NotFound, "calendar not found: %s", id)
+		}
+		calendarIDs = append(calendarIDs, id)
+	}
+
+	var conflicts []*pb.Conflict
+	for _, calendarID := range calendarIDs {
+		existing, err := s.expandCalendar(calendarID, start, end)
+		if err != nil {
+			return nil, err
+		}
+		conflicts = append(conflicts, overlapping(event, instances, existing, maxReportedConflicts-len(conflicts))...)
+		if len(conflicts) >= maxReportedConflicts {
+			break
+		}
+	}
+
+	if len(conflicts) > 0 && check.policy == pb.ConflictPolicy_CONFLICT_POLICY_REJECT {
+		return nil, conflictError(conflicts)
+	}
+	return conflicts, nil
+}
+
+// overlapping はinstancesとexistingで期間が重なる組を最大limit件返す
+// どちらも開始時刻順に並んでいるものとし、event自身のインスタンスとの重なりは除く
+// 長さが0のインスタンスは時間を占有しないため重なりとみなさない
+func overlapping(event *models.Event, instances, existing []*models.Event, limit int) []*pb.Conflict {
+	// existingの開始時刻が (インスタンスの開始 - existingの最長の長さ) 以前のものは重なりえないため読み飛ばす
+	var longest time.Duration
+	for _, e := range existing {
+		if d := e.DTEnd.Sub(e.DTStart); d > longest {
+			longest = d
+		}
+	}
+
+	var conflicts []*pb.Conflict
+	lo := 0
+	for _, instance := range instances {
+		for lo < len(existing) && !existing[lo].DTStart.Add(longest).After(instance.DTStart) {
+			lo++
+		}
+		for _, e := range existing[lo:] {
+			if !e.DTStart.Before(instance.DTEnd) {
+				break
+			}
+			if e.ID == event.ID || e.RecurringEventID == event.ID || !instance.DTStart.Before(e.DTEnd) ||
+				!e.DTStart.Before(e.DTEnd) || !instance.DTStart.Before(instance.DTEnd) {
+				continue
+			}
+			if len(conflicts) == limit {
+				return conflicts
+			}
+			conflicts = append(conflicts, conflictToProto(instance, e))
+		}
+	}
+	return conflicts
+}
+
+func conflictToProto(instance, existing *models.Event) *pb.Conflict {
+	return &pb.Conflict{
+		EventId:          existing.ID,
+		RecurringEventId: existing.RecurringEventID,
+		CalendarId:       existing.CalendarID,
+		Start:            existing.DTStart.UTC().Format(time.RFC3339),
+		End:              existing.DTEnd.UTC().Format(time.RFC3339),
+		OccurrenceStart:  instance.DTStart.UTC().Format(time.RFC3339),
+	}
+}
+
+// conflictError は重なっているインスタンスをgoogle.rpc.PreconditionFailureの詳細に含むFailedPreconditionエラーを作成する
+func conflictError(conflicts []*pb.Conflict) error {
+	violations := make([]*errdetails.PreconditionFailure_Violation, len(conflicts))
+	for i, c := range conflicts {
+		violations[i] = &errdetails.PreconditionFailure_Violation{
+			Type:        "CONFLICT",
+			Subject:     "calendars/" + c.CalendarId + "/events/" + c.EventId,
+			Description: fmt.Sprintf("occurrence at %s overlaps an existing event from %s to %s", c.OccurrenceStart, c.Start, c.End),
+		}
+	}
+
+	st := status.New(codes.FailedPrecondition, fmt.Sprintf("event conflicts with %d existing occurrence(s)", len(conflicts)))
+	detailed, err := st.WithDetails(&errdetails.PreconditionFailure{Violations: violations})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,137 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/recurrence-scheduler/internal/models"
+	pb "github.com/recurrence-scheduler/proto/scheduler/v1"
+)
+
+func TestCheckConflicts(t *testing.T) {
+	s, st, cal := newTestServer(t)
+	room := models.NewCalendar("room", "", "UTC")
+	if err := st.CreateCalendar(room); err != nil {
+		t.Fatal(err)
+	}
+
+	// 過去の開始は現在時刻に切り上げられるため、将来の日時で比較する
+	start := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 7).Add(9 * time.Hour)
+	create := func(e *models.Event) *models.Event {
+		t.Helper()
+		if err := st.CreateEvent(e); err != nil {
+			t.Fatal(err)
+		}
+		return e
+	}
+	// cal: 3日後の9:30-10:30。room: 5日後の9:00-10:00。隣接するだけの予定は重なりとみなさない
+	meeting := create(models.NewEvent(cal.ID, "meeting", "", start.AddDate(0, 0, 3).Add(30*time.Minute), start.AddDate(0, 0, 3).Add(90*time.Minute), "", "UTC"))
+	booking := create(models.NewEvent(room.ID, "booking", "", start.AddDate(0, 0, 5), start.AddDate(0, 0, 5).Add(time.Hour), "", "UTC"))
+	create(models.NewEvent(cal.ID, "adjacent", "", start.Add(time.Hour), start.Add(2*time.Hour), "", "UTC"))
+
+	daily := models.NewEvent(cal.ID, "standup", "", start, start.Add(time.Hour), "FREQ=DAILY;COUNT=7", "UTC")
+
+	tests := []struct {
+		name  string
+		event *models.Event
+		check conflictCheck
+		// 重なる既存イベントのID
+		want     []string
+		wantCode codes.Code
+	}{
+		{name: "unspecified", event: daily, check: conflictCheck{}},
+		{name: "ignore", event: daily, check: conflictCheck{policy: pb.ConflictPolicy_CONFLICT_POLICY_IGNORE}},
+		{name: "warn", event: daily, check: conflictCheck{policy: pb.ConflictPolicy_CONFLICT_POLICY_WARN}, want: []string{meeting.ID}},
+		{
+			name:  "warn with resource calendars",
+			event: daily,
+			check: conflictCheck{policy: pb.ConflictPolicy_CONFLICT_POLICY_WARN, calendarIDs: []string{room.ID, cal.ID}},
+			want:  []string{meeting.ID, booking.ID},
+		},
+		{
+			name:  "horizon limits the expansion",
+			event: daily,
+			check: conflictCheck{policy: pb.ConflictPolicy_CONFLICT_POLICY_WARN, horizonDays: 2},
+		},
+		{
+			// 更新するイベント自身とは重ならない
+			name:  "update of the existing event",
+			event: meeting,
+			check: conflictCheck{policy: pb.ConflictPolicy_CONFLICT_POLICY_REJECT},
+		},
+		{name: "reject", event: daily, check: conflictCheck{policy: pb.ConflictPolicy_CONFLICT_POLICY_REJECT}, wantCode: codes.FailedPrecondition},
+		{
+			name:     "unknown resource calendar",
+			event:    daily,
+			check:    conflictCheck{policy: pb.ConflictPolicy_CONFLICT_POLICY_WARN, calendarIDs: []string{"missing"}},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "horizon out of range",
+			event:    daily,
+			check:    conflictCheck{policy: pb.ConflictPolicy_CONFLICT_POLICY_WARN, horizonDays: maxConflictHorizonDays + 1},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conflicts, err := s.checkConflicts(tt.event, tt.check)
+			if tt.wantCode != codes.OK {
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("got error %v, want %v", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, c := range conflicts {
+				got = append(got, c.EventId)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("conflicts = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("conflict %d = %s, want %s", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCheckConflictsRejectDetails(t *testing.T) {
+	s, store, cal := newTestServer(t)
+	start := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 7).Add(9 * time.Hour)
+	existing := models.NewEvent(cal.ID, "meeting", "", start.Add(30*time.Minute), start.Add(90*time.Minute), "", "UTC")
+	if err := store.CreateEvent(existing); err != nil {
+		t.Fatal(err)
+	}
+
+	event := models.NewEvent(cal.ID, "standup", "", start, start.Add(time.Hour), "", "UTC")
+	_, err := s.checkConflicts(event, conflictCheck{policy: pb.ConflictPolicy_CONFLICT_POLICY_REJECT})
+
+	st := status.Convert(err)
+	if st.Code() != codes.FailedPrecondition {
+		t.Fatalf("code = %v, want %v", st.Code(), codes.FailedPrecondition)
+	}
+	var violations []*errdetails.PreconditionFailure_Violation
+	for _, d := range st.Details() {
+		if pf, ok := d.(*errdetails.PreconditionFailure); ok {
+			violations = append(violations, pf.Violations...)
+		}
+	}
+	if len(violations) != 1 {
+		t.Fatalf("got %d violations, want 1", len(violations))
+	}
+	if v := violations[0]; v.Type != "CONFLICT" || v.Subject != "calendars/"+cal.ID+"/events/"+existing.ID {
+		t.Errorf("violation = %s %s", v.Type, v.Subject)
+	}
+}
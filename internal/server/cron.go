@@ -0,0 +1,46 @@
+package server
+
+import (
+	"github.com/teambition/rrule-go"
+
+	"github.com/recurrence-scheduler/internal/cron"
+	"github.com/recurrence-scheduler/internal/models"
+	"github.com/recurrence-scheduler/internal/recurrence"
+)
+
+// applyCron はcron式をRRULEに変換してeventに設定する
+// cron式の時刻はイベントのタイムゾーンで解釈する
+// DTSTARTはevent.DTStart以降の最初の実行時刻に合わせ、DTENDまでの長さは保つ
+func applyCron(expr string, event *models.Event) error {
+	rule, err := cron.ToRRule(expr)
+	if err != nil {
+		return invalidCron(err.Error())
+	}
+
+	option, err := rrule.StrToROption(rule)
+	if err != nil {
+		return invalidCron(err.Error())
+	}
+	dtStart := event.DTStart.In(recurrence.Location(event.Timezone))
+	option.Dtstart = dtStart
+	rr, err := rrule.NewRRule(*option)
+	if err != nil {
+		return invalidCron(err.Error())
+	}
+	start := rr.After(dtStart, true)
+	if start.IsZero() {
+		return invalidCron("the schedule never runs after dtstart")
+	}
+	if err := validateRRule(rule, start); err != nil {
+		return err
+	}
+
+	duration := event.DTEnd.Sub(event.DTStart)
+	event.DTStart, event.DTEnd, event.RRule = start.UTC(), start.UTC().Add(duration), rule
+	return nil
+}
+
+// invalidCron はcronフィールドの違反を詳細に含むInvalidArgumentエラーを作成する
+func invalidCron(description string) error {
+	return invalidArgument("invalid cron expression", fieldViolation("cron", description))
+}
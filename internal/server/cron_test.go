@@ -0,0 +1,69 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/recurrence-scheduler/internal/models"
+)
+
+func TestApplyCron(t *testing.T) {
+	// 2025-01-06は月曜日
+	start := time.Date(2025, 1, 6, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		expr      string
+		wantCode  codes.Code
+		wantStart time.Time
+		wantRRule string
+	}{
+		{
+			name:      "dtstart moves to the first run",
+			expr:      "0 10 * * 1-5",
+			wantStart: time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC),
+			wantRRule: "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR;BYHOUR=10;BYMINUTE=0;BYSECOND=0",
+		},
+		{
+			name:      "last weekday of the month",
+			expr:      "0 18 LW * *",
+			wantStart: time.Date(2025, 1, 31, 18, 0, 0, 0, time.UTC),
+			wantRRule: "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYHOUR=18;BYMINUTE=0;BYSECOND=0;BYSETPOS=-1",
+		},
+		{
+			// 2月1日は土曜日
+			name:      "first weekday of the month",
+			expr:      "0 9 1W * *",
+			wantStart: time.Date(2025, 2, 3, 9, 0, 0, 0, time.UTC),
+			wantRRule: "FREQ=MONTHLY;BYMONTHDAY=1,2,3;BYDAY=MO,TU,WE,TH,FR;BYHOUR=9;BYMINUTE=0;BYSECOND=0;BYSETPOS=1",
+		},
+		{name: "nearest weekday of another day", expr: "0 0 15W * *", wantCode: codes.InvalidArgument},
+		{name: "day-of-month OR day-of-week", expr: "0 0 13 * 5", wantCode: codes.InvalidArgument},
+		{name: "no RRULE equivalent", expr: "@reboot", wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := models.NewEvent("cal", "job", "", start, start.Add(15*time.Minute), "", "UTC")
+			err := applyCron(tt.expr, event)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("code = %v, want %v (err: %v)", code, tt.wantCode, err)
+			}
+			if err != nil {
+				return
+			}
+			if !event.DTStart.Equal(tt.wantStart) {
+				t.Errorf("DTSTART = %v, want %v", event.DTStart, tt.wantStart)
+			}
+			if d := event.DTEnd.Sub(event.DTStart); d != 15*time.Minute {
+				t.Errorf("duration = %v, want 15m", d)
+			}
+			if event.RRule != tt.wantRRule {
+				t.Errorf("RRULE = %q, want %q", event.RRule, tt.wantRRule)
+			}
+		})
+	}
+}
@@ -0,0 +1,630 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/recurrence-scheduler/internal/recurrence"
+	pb "github.com/recurrence-scheduler/proto/scheduler/v1"
+)
+
+// language は繰り返しルールの説明に使う言語
+type language int
+
+const (
+	english language = iota
+	japanese
+)
+
+// maxListedTimes は時刻を個別に列挙する上限（超える場合は時と分を分けて書く）
+const maxListedTimes = 6
+
+// parseLanguage はBCP 47の言語タグ（"ja"、"en-US"など）から言語を決める
+// 空の場合は英語。対応していない言語の場合はfalseを返す
+func parseLanguage(tag string) (language, bool) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	switch {
+	case tag == "", tag == "*", tag == "en", strings.HasPrefix(tag, "en-"):
+		return english, true
+	case tag == "ja", strings.HasPrefix(tag, "ja-"):
+		return japanese, true
+	}
+	return english, false
+}
+
+// languageFromHeader はAccept-Languageヘッダーの値から最初に対応している言語を返す（なければ英語）
+// ブラウザは優先度の高い順に並べて送るため、q値は見ない
+func languageFromHeader(header string) language {
+	for _, item := range strings.Split(header, ",") {
+		tag, _, _ := strings.Cut(item, ";")
+		if tag = strings.TrimSpace(tag); tag == "" || tag == "*" {
+			continue
+		}
+		if lang, ok := parseLanguage(tag); ok {
+			return lang
+		}
+	}
+	return english
+}
+
+// languageFromContext はリクエストのAccept-Languageから言語を決める
+// gRPCではaccept-languageメタデータ、REST gatewayではgrpcgateway-accept-languageとして渡される
+func languageFromContext(ctx context.Context) language {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, key := range []string{"accept-language", "grpcgateway-accept-language"} {
+		if values := md.Get(key); len(values) > 0 {
+			return languageFromHeader(values[0])
+		}
+	}
+	return english
+}
+
+// DescribeRule は繰り返しルールを指定した言語の文章で説明する
+func (s *Server) DescribeRule(ctx context.Context, req *pb.DescribeRuleRequest) (*pb.DescribeRuleResponse, error) {
+	if req.Rrule == nil {
+		return nil, status.Error(codes.InvalidArgument, "rrule is required")
+	}
+	lang, ok := parseLanguage(req.Locale)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "unsupported locale: "+req.Locale+"; use en or ja")
+	}
+
+	timezone := req.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	if err := validateTimezone(timezone); err != nil {
+		return nil, err
+	}
+	loc, _ := time.LoadLocation(timezone)
+
+	// DTSTARTは省略できる。省略した場合はBYHOURだけのルールの分を説明できない
+	var dtStart time.Time
+	if req.Dtstart != "" {
+		t, err := parseTime(req.Dtstart)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid dtstart")
+		}
+		dtStart = t.In(loc)
+	}
+
+	rruleStr := protoToRRule(req.Rrule)
+	if err := validateRRule(rruleStr, dtStart); err != nil {
+		return nil, err
+	}
+	// 検証済みの文字列から解析し直し、大文字小文字などの表記を揃える
+	rr, _ := rruleToProto(rruleStr)
+
+	return &pb.DescribeRuleResponse{Text: describeRule(rr, dtStart, loc, lang)}, nil
+}
+
+// describeRule は繰り返しルールを"Every 2 weeks on Monday and Wednesday, 10 times"や
+// "2週間ごとの月曜日と水曜日（10回）"のような文章にする
+// dtStartはBYHOURだけを指定したルールの分を補うために使う（ゼロ値の場合は補わない）。UNTILはlocの日付で表す
+func describeRule(rr *pb.RecurrenceRule, dtStart time.Time, loc *time.Location, lang language) string {
+	if rr == nil || rr.Freq == "" {
+		return ""
+	}
+	d := describer{rr: rr, dtStart: dtStart, loc: loc}
+	if lang == japanese {
+		return d.japanese()
+	}
+	return d.english()
+}
+
+type describer struct {
+	rr      *pb.RecurrenceRule
+	dtStart time.Time
+	loc     *time.Location
+}
+
+var englishWeekdays = map[string]string{
+	"MO": "Monday", "TU": "Tuesday", "WE": "Wednesday", "TH": "Thursday", "FR": "Friday", "SA": "Saturday", "SU": "Sunday",
+}
+
+var japaneseWeekdays = map[string]string{
+	"MO": "月曜日", "TU": "火曜日", "WE": "水曜日", "TH": "木曜日", "FR": "金曜日", "SA": "土曜日", "SU": "日曜日",
+}
+
+var weekdayOrder = []string{"MO", "TU", "WE", "TH", "FR", "SA", "SU"}
+
+// englishUnits はFREQごとの単位（単数形）
+var englishUnits = map[string]string{
+	"SECONDLY": "second", "MINUTELY": "minute", "HOURLY": "hour", "DAILY": "day", "WEEKLY": "week", "MONTHLY": "month", "YEARLY": "year",
+}
+
+// japaneseFreqs はFREQごとの「毎〜」と「n〜ごと」の単位
+var japaneseFreqs = map[string][2]string{
+	"SECONDLY": {"毎秒", "秒"}, "MINUTELY": {"毎分", "分"}, "HOURLY": {"毎時", "時間"}, "DAILY": {"毎日", "日"},
+	"WEEKLY": {"毎週", "週間"}, "MONTHLY": {"毎月", "か月"}, "YEARLY": {"毎年", "年"},
+}
+
+func (d describer) english() string {
+	rr := d.rr
+	var b strings.Builder
+
+	unit := englishUnits[rr.Freq]
+	weekly := d.everyWeek()
+	switch {
+	case weekly:
+		plain, _ := splitWeekdays(rr.Byday)
+		if isWeekdays(plain) {
+			b.WriteString("Every weekday")
+			break
+		}
+		days := make([]string, len(plain))
+		for i, w := range plain {
+			days[i] = englishWeekdays[w]
+		}
+		b.WriteString("Every " + joinEnglish(days))
+	case rr.Interval > 1:
+		fmt.Fprintf(&b, "Every %d %ss", rr.Interval, unit)
+	default:
+		b.WriteString("Every " + unit)
+	}
+
+	if len(rr.Bymonth) > 0 {
+		months := make([]string, len(rr.Bymonth))
+		for i, m := range rr.Bymonth {
+			months[i] = time.Month(m).String()
+		}
+		b.WriteString(" in " + joinEnglish(months))
+	}
+	if len(rr.Byweekno) > 0 {
+		b.WriteString(" in " + plural("week", len(rr.Byweekno)) + " " + joinEnglish(formatNumbers(rr.Byweekno)))
+	}
+	if len(rr.Byyearday) > 0 {
+		days := make([]string, len(rr.Byyearday))
+		for i, n := range rr.Byyearday {
+			days[i] = englishPosition(n)
+		}
+		b.WriteString(" on the " + joinEnglish(days) + " day of the year")
+	}
+	if len(rr.Bymonthday) > 0 {
+		days := make([]string, len(rr.Bymonthday))
+		for i, n := range rr.Bymonthday {
+			days[i] = englishPosition(n)
+			if n < 0 {
+				days[i] += " day"
+			}
+		}
+		b.WriteString(" on the " + joinEnglish(days))
+	}
+	if len(rr.Byday) > 0 && !weekly {
+		b.WriteString(" on " + d.englishDays())
+	}
+	if times := d.englishTimes(); times != "" {
+		b.WriteString(" at " + times)
+	}
+	if len(rr.Bysetpos) > 0 && len(rr.Byday) == 0 {
+		b.WriteString(" (the " + englishPositions(rr.Bysetpos) + " occurrence in each " + unit + ")")
+	}
+
+	if rr.Count == 1 {
+		b.WriteString(", once")
+	} else if rr.Count > 1 {
+		fmt.Fprintf(&b, ", %d times", rr.Count)
+	}
+	if until, ok := d.until(); ok {
+		b.WriteString(", until " + until.Format("January 2, 2006"))
+	} else if rr.Until != "" {
+		b.WriteString(", until " + rr.Until)
+	}
+	return b.String()
+}
+
+// englishDays はBYDAY（BYSETPOSがあればそれも含めて）を説明する
+func (d describer) englishDays() string {
+	plain, nth := splitWeekdays(d.rr.Byday)
+	var days []string
+	switch {
+	case len(nth) > 0 || len(d.rr.Bysetpos) == 0:
+	case isWeekdays(plain):
+		return "the " + englishPositions(d.rr.Bysetpos) + " weekday"
+	case len(plain) == 1:
+		return "the " + englishPositions(d.rr.Bysetpos) + " " + englishWeekdays[plain[0]]
+	}
+
+	switch {
+	case isWeekdays(plain):
+		days = append(days, "weekdays")
+	case isWeekend(plain):
+		days = append(days, "weekends")
+	default:
+		for _, w := range plain {
+			days = append(days, englishWeekdays[w])
+		}
+	}
+	for _, w := range nth {
+		days = append(days, "the "+englishPosition(int32(w.n))+" "+englishWeekdays[w.weekday])
+	}
+	text := joinEnglish(days)
+	if len(d.rr.Bysetpos) > 0 {
+		text = "the " + englishPositions(d.rr.Bysetpos) + " of " + text
+	}
+	return text
+}
+
+// englishTimes はBYHOUR・BYMINUTE・BYSECONDを説明する（なければ空）
+func (d describer) englishTimes() string {
+	hours, minutes, seconds := d.timeParts()
+	switch {
+	case len(hours) > 0 && len(minutes) > 0 && len(hours)*len(minutes)*max(len(seconds), 1) <= maxListedTimes:
+		return joinEnglish(formatClockTimes(hours, minutes, seconds))
+	case len(hours) > 0 && len(minutes) > 0:
+		return plural("minute", len(minutes)) + " " + joinEnglish(compressRanges(minutes)) +
+			" of " + plural("hour", len(hours)) + " " + joinEnglish(compressRanges(hours))
+	case len(hours) > 0:
+		return plural("hour", len(hours)) + " " + joinEnglish(compressRanges(hours))
+	case len(minutes) > 0:
+		return joinEnglish(compressRanges(minutes)) + " " + plural("minute", len(minutes)) + " past the hour"
+	case len(seconds) > 0:
+		return plural("second", len(seconds)) + " " + joinEnglish(compressRanges(seconds))
+	}
+	return ""
+}
+
+func (d describer) japanese() string {
+	rr := d.rr
+	var b strings.Builder
+
+	freq := japaneseFreqs[rr.Freq]
+	weekly := d.everyWeek()
+	// 「毎週月曜日」のように続けるが、「2週間ごと」の後には「の」を入れる
+	sep := ""
+	switch {
+	case weekly:
+		b.WriteString(japaneseFreqs["WEEKLY"][0])
+	case rr.Interval > 1:
+		fmt.Fprintf(&b, "%d%sごと", rr.Interval, freq[1])
+		sep = "の"
+	default:
+		b.WriteString(freq[0])
+	}
+
+	var parts []string
+	if len(rr.Bymonth) > 0 {
+		months := make([]string, len(rr.Bymonth))
+		for i, m := range rr.Bymonth {
+			months[i] = strconv.Itoa(int(m)) + "月"
+		}
+		parts = append(parts, joinJapanese(months))
+	}
+	if len(rr.Byweekno) > 0 {
+		weeks := make([]string, len(rr.Byweekno))
+		for i, n := range rr.Byweekno {
+			weeks[i] = "第" + strconv.Itoa(int(n)) + "週"
+		}
+		parts = append(parts, joinJapanese(weeks))
+	}
+	if len(rr.Byyearday) > 0 {
+		days := make([]string, len(rr.Byyearday))
+		for i, n := range rr.Byyearday {
+			days[i] = japaneseNthDay(n, "日目", "最終日")
+		}
+		parts = append(parts, joinJapanese(days))
+	}
+	if len(rr.Bymonthday) > 0 {
+		days := make([]string, len(rr.Bymonthday))
+		for i, n := range rr.Bymonthday {
+			days[i] = japaneseNthDay(n, "日", "末日")
+		}
+		parts = append(parts, joinJapanese(days))
+	}
+	if len(rr.Byday) > 0 {
+		parts = append(parts, d.japaneseDays())
+	}
+	if weekly && len(parts) > 0 {
+		// 「毎週」の直後は曜日なので区切らない
+		b.WriteString(parts[0])
+		parts = parts[1:]
+		sep = "の"
+	}
+	if times := d.japaneseTimes(); times != "" {
+		parts = append(parts, times)
+	}
+	if len(rr.Bysetpos) > 0 && len(rr.Byday) == 0 && len(parts) > 0 {
+		parts[len(parts)-1] += "のうち" + japanesePositions(rr.Bysetpos)
+	}
+	if len(parts) > 0 {
+		b.WriteString(sep + strings.Join(parts, "の"))
+	}
+
+	var limits []string
+	if rr.Count > 0 {
+		limits = append(limits, strconv.Itoa(int(rr.Count))+"回")
+	}
+	if until, ok := d.until(); ok {
+		limits = append(limits, until.Format("2006年1月2日")+"まで")
+	} else if rr.Until != "" {
+		limits = append(limits, rr.Until+"まで")
+	}
+	if len(limits) > 0 {
+		b.WriteString("（" + strings.Join(limits, "、") + "）")
+	}
+	return b.String()
+}
+
+// japaneseDays はBYDAY（BYSETPOSがあればそれも含めて）を説明する
+func (d describer) japaneseDays() string {
+	plain, nth := splitWeekdays(d.rr.Byday)
+	switch {
+	case len(nth) > 0 || len(d.rr.Bysetpos) == 0:
+	case isWeekdays(plain):
+		return japanesePositions(d.rr.Bysetpos) + "の平日"
+	case len(plain) == 1:
+		return japanesePositions(d.rr.Bysetpos) + "の" + japaneseWeekdays[plain[0]]
+	}
+
+	var days []string
+	switch {
+	case isWeekdays(plain):
+		days = append(days, "平日")
+	case isWeekend(plain):
+		days = append(days, "週末")
+	default:
+		for _, w := range plain {
+			days = append(days, japaneseWeekdays[w])
+		}
+	}
+	for _, w := range nth {
+		switch {
+		case w.n == -1:
+			days = append(days, "最終"+japaneseWeekdays[w.weekday])
+		case w.n < 0:
+			days = append(days, fmt.Sprintf("最後から%d番目の%s", -w.n, japaneseWeekdays[w.weekday]))
+		default:
+			days = append(days, fmt.Sprintf("第%d%s", w.n, japaneseWeekdays[w.weekday]))
+		}
+	}
+	text := joinJapanese(days)
+	if len(d.rr.Bysetpos) > 0 {
+		text += "のうち" + japanesePositions(d.rr.Bysetpos)
+	}
+	return text
+}
+
+// japaneseTimes はBYHOUR・BYMINUTE・BYSECONDを説明する（なければ空）
+func (d describer) japaneseTimes() string {
+	hours, minutes, seconds := d.timeParts()
+	suffix := func(values []int, unit string) []string {
+		ranges := compressRanges(values)
+		for i := range ranges {
+			ranges[i] = strings.Replace(ranges[i], "-", "〜", 1) + unit
+		}
+		return ranges
+	}
+	switch {
+	case len(hours) > 0 && len(minutes) > 0 && len(hours)*len(minutes)*max(len(seconds), 1) <= maxListedTimes:
+		return joinJapanese(formatClockTimes(hours, minutes, seconds))
+	case len(hours) > 0 && len(minutes) > 0:
+		return joinJapanese(suffix(hours, "時")) + "の" + joinJapanese(suffix(minutes, "分"))
+	case len(hours) > 0:
+		return joinJapanese(suffix(hours, "時"))
+	case len(minutes) > 0:
+		return joinJapanese(suffix(minutes, "分"))
+	case len(seconds) > 0:
+		return joinJapanese(suffix(seconds, "秒"))
+	}
+	return ""
+}
+
+// everyWeek は毎日または毎週の、序数のない曜日だけで絞り込んだルールかを返す
+// このようなルールは「毎週月曜日と水曜日」のように曜日を主語にして書く
+func (d describer) everyWeek() bool {
+	rr := d.rr
+	if rr.Interval > 1 || (rr.Freq != "DAILY" && rr.Freq != "WEEKLY") || len(rr.Byday) == 0 || len(rr.Bysetpos) > 0 ||
+		len(rr.Bymonth) > 0 || len(rr.Bymonthday) > 0 || len(rr.Byweekno) > 0 || len(rr.Byyearday) > 0 {
+		return false
+	}
+	_, nth := splitWeekdays(rr.Byday)
+	return len(nth) == 0
+}
+
+// timeParts はBYHOUR・BYMINUTE・BYSECONDを返す
+// BYHOURだけを指定した場合、分と秒はDTSTARTから補う（DTSTARTが分からなければ補わない）
+// 0秒だけの場合は秒を省く
+func (d describer) timeParts() (hours, minutes, seconds []int) {
+	hours, minutes, seconds = toInts(d.rr.Byhour), toInts(d.rr.Byminute), toInts(d.rr.Bysecond)
+	if len(hours) > 0 && len(minutes) == 0 && !d.dtStart.IsZero() {
+		minutes = []int{d.dtStart.Minute()}
+		if len(seconds) == 0 {
+			seconds = []int{d.dtStart.Second()}
+		}
+	}
+	if len(seconds) == 1 && seconds[0] == 0 && (len(hours) > 0 || len(minutes) > 0) {
+		seconds = nil
+	}
+	return hours, minutes, seconds
+}
+
+// until はUNTILをlocの日時として返す（解析できなければfalse）
+func (d describer) until() (time.Time, bool) {
+	if d.rr.Until == "" {
+		return time.Time{}, false
+	}
+	until, err := time.Parse(recurrence.UntilLayout, d.rr.Until)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return until.In(d.loc), true
+}
+
+type nthWeekday struct {
+	n       int
+	weekday string
+}
+
+// splitWeekdays はBYDAYを序数なしの曜日（月曜日から順）と序数付きの曜日に分ける
+func splitWeekdays(byDay []string) (plain []string, nth []nthWeekday) {
+	for _, day := range byDay {
+		weekday := day[len(day)-2:]
+		if len(day) == 2 {
+			plain = append(plain, weekday)
+			continue
+		}
+		n, _ := strconv.Atoi(day[:len(day)-2])
+		nth = append(nth, nthWeekday{n: n, weekday: weekday})
+	}
+	slices.SortFunc(plain, func(a, b string) int {
+		return slices.Index(weekdayOrder, a) - slices.Index(weekdayOrder, b)
+	})
+	return slices.Compact(plain), nth
+}
+
+func isWeekdays(days []string) bool {
+	return slices.Equal(days, weekdayOrder[:5])
+}
+
+func isWeekend(days []string) bool {
+	return slices.Equal(days, weekdayOrder[5:])
+}
+
+// formatClockTimes は時・分・秒の組み合わせを"9:00"や"9:00:30"の形式で列挙する
+func formatClockTimes(hours, minutes, seconds []int) []string {
+	var times []string
+	for _, h := range hours {
+		for _, m := range minutes {
+			if len(seconds) == 0 {
+				times = append(times, fmt.Sprintf("%d:%02d", h, m))
+				continue
+			}
+			for _, s := range seconds {
+				times = append(times, fmt.Sprintf("%d:%02d:%02d", h, m, s))
+			}
+		}
+	}
+	return times
+}
+
+// compressRanges は昇順の値のリストを、3つ以上連続する部分を"9-17"のようにまとめて文字列にする
+func compressRanges(values []int) []string {
+	values = slices.Clone(values)
+	slices.Sort(values)
+	var ranges []string
+	for i := 0; i < len(values); {
+		j := i
+		for j+1 < len(values) && values[j+1] == values[j]+1 {
+			j++
+		}
+		if j-i >= 2 {
+			ranges = append(ranges, fmt.Sprintf("%d-%d", values[i], values[j]))
+			i = j + 1
+			continue
+		}
+		ranges = append(ranges, strconv.Itoa(values[i]))
+		i++
+	}
+	return ranges
+}
+
+// englishPosition は序数を"1st"や"last"、"2nd to last"の形式で表す
+func englishPosition(n int32) string {
+	switch {
+	case n == -1:
+		return "last"
+	case n < 0:
+		return englishOrdinal(-n) + " to last"
+	}
+	return englishOrdinal(n)
+}
+
+func englishPositions(positions []int32) string {
+	texts := make([]string, len(positions))
+	for i, n := range positions {
+		texts[i] = englishPosition(n)
+	}
+	return joinEnglish(texts)
+}
+
+func englishOrdinal(n int32) string {
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return strconv.Itoa(int(n)) + suffix
+}
+
+// japanesePositions はBYSETPOSを"最初"や"最後から2番目"の形式で表す
+func japanesePositions(positions []int32) string {
+	texts := make([]string, len(positions))
+	for i, n := range positions {
+		switch {
+		case n == 1:
+			texts[i] = "最初"
+		case n == -1:
+			texts[i] = "最後"
+		case n < 0:
+			texts[i] = fmt.Sprintf("最後から%d番目", -n)
+		default:
+			texts[i] = fmt.Sprintf("%d番目", n)
+		}
+	}
+	return joinJapanese(texts)
+}
+
+// japaneseNthDay は日の番号を"15日"や"末日"、"末日の2日前"の形式で表す
+func japaneseNthDay(n int32, unit, last string) string {
+	switch {
+	case n == -1:
+		return last
+	case n == -2:
+		return last + "の前日"
+	case n < 0:
+		return fmt.Sprintf("%sの%d日前", last, -n-1)
+	}
+	return strconv.Itoa(int(n)) + unit
+}
+
+// joinEnglish は"A, B and C"の形式で連結する
+func joinEnglish(items []string) string {
+	if len(items) <= 1 {
+		return strings.Join(items, "")
+	}
+	return strings.Join(items[:len(items)-1], ", ") + " and " + items[len(items)-1]
+}
+
+// joinJapanese は2つなら"AとB"、3つ以上なら"A、B、C"の形式で連結する
+func joinJapanese(items []string) string {
+	if len(items) == 2 {
+		return items[0] + "と" + items[1]
+	}
+	return strings.Join(items, "、")
+}
+
+func plural(word string, n int) string {
+	if n == 1 {
+		return word
+	}
+	return word + "s"
+}
+
+func formatNumbers(values []int32) []string {
+	texts := make([]string, len(values))
+	for i, v := range values {
+		texts[i] = strconv.Itoa(int(v))
+	}
+	return texts
+}
+
+func toInts(values []int32) []int {
+	ints := make([]int, len(values))
+	for i, v := range values {
+		ints[i] = int(v)
+	}
+	return ints
+}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDescribeRule(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skip(err)
+	}
+	// BYHOURだけのルールの分はDTSTARTから補う
+	dtStart := time.Date(2025, 1, 6, 9, 30, 0, 0, tokyo)
+
+	tests := []struct {
+		rrule    string
+		english  string
+		japanese string
+	}{
+		{"FREQ=DAILY", "Every day", "毎日"},
+		{"FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=10", "Every 2 weeks on Monday and Wednesday, 10 times", "2週間ごとの月曜日と水曜日（10回）"},
+		{"FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR", "Every weekday", "毎週平日"},
+		{"FREQ=DAILY;COUNT=1", "Every day, once", "毎日（1回）"},
+		// UNTILはタイムゾーンの日付で表す（UTCでは3月31日）
+		{"FREQ=DAILY;UNTIL=20250331T150000Z", "Every day, until April 1, 2025", "毎日（2025年4月1日まで）"},
+		{"FREQ=HOURLY;INTERVAL=3;COUNT=5;UNTIL=20250331T150000Z", "Every 3 hours, 5 times, until April 1, 2025", "3時間ごと（5回、2025年4月1日まで）"},
+		{"FREQ=MONTHLY;BYMONTHDAY=-1", "Every month on the last day", "毎月末日"},
+		{"FREQ=MONTHLY;BYDAY=2TU,-1FR", "Every month on the 2nd Tuesday and the last Friday", "毎月第2火曜日と最終金曜日"},
+		{"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", "Every month on the last weekday", "毎月最後の平日"},
+		{"FREQ=MONTHLY;BYMONTHDAY=1,15;BYSETPOS=1", "Every month on the 1st and 15th (the 1st occurrence in each month)", "毎月1日と15日のうち最初"},
+		{"FREQ=YEARLY;BYMONTH=3;BYMONTHDAY=1;BYHOUR=9", "Every year in March on the 1st at 9:30", "毎年3月の1日の9:30"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rrule, func(t *testing.T) {
+			rr, err := rruleToProto(tt.rrule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := describeRule(rr, dtStart, tokyo, english); got != tt.english {
+				t.Errorf("english = %q, want %q", got, tt.english)
+			}
+			if got := describeRule(rr, dtStart, tokyo, japanese); got != tt.japanese {
+				t.Errorf("japanese = %q, want %q", got, tt.japanese)
+			}
+		})
+	}
+}
+
+func TestDescribeRuleEmpty(t *testing.T) {
+	if got := describeRule(nil, time.Time{}, time.UTC, english); got != "" {
+		t.Errorf("describeRule(nil) = %q, want empty", got)
+	}
+}
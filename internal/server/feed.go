@@ -0,0 +1,129 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/recurrence-scheduler/internal/ical"
+	"github.com/recurrence-scheduler/internal/models"
+	pb "github.com/recurrence-scheduler/proto/scheduler/v1"
+)
+
+// feedPathPrefix は購読フィードのURLの接頭辞
+// トークンはカレンダーIDを知らせずに購読URLを渡すためのもので、アクセス制御ではない
+// 同じ内容は /api/v1/calendars/{id}.ics や /dav/ からも読めるため、公開範囲はサーバー全体の前段で制限する
+const feedPathPrefix = "/feeds/"
+
+// feedRefreshInterval はカレンダーアプリに通知する再取得間隔
+const feedRefreshInterval = "PT1H"
+
+// RotateFeedToken はカレンダーの購読用トークンを新しく発行する
+// 既存のトークンは無効になるため、以前のURLで購読しているクライアントは再購読が必要になる
+func (s *Server) RotateFeedToken(ctx context.Context, req *pb.RotateFeedTokenRequest) (*pb.RotateFeedTokenResponse, error) {
+	if _, err := s.storage.GetCalendar(req.CalendarId); err != nil {
+		return nil, status.Error(codes.NotFound, "calendar not found")
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := s.storage.SetFeedToken(req.CalendarId, token); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.RotateFeedTokenResponse{FeedToken: token, FeedPath: feedPathPrefix + token + ".ics"}, nil
+}
+
+// RevokeFeedToken はカレンダーの購読フィードを無効にする
+func (s *Server) RevokeFeedToken(ctx context.Context, req *pb.RevokeFeedTokenRequest) (*pb.RevokeFeedTokenResponse, error) {
+	if _, err := s.storage.GetCalendar(req.CalendarId); err != nil {
+		return nil, status.Error(codes.NotFound, "calendar not found")
+	}
+
+	if err := s.storage.SetFeedToken(req.CalendarId, ""); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.RevokeFeedTokenResponse{}, nil
+}
+
+// newToken は推測できない256ビットのトークンを生成する
+func newToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// ServeFeed は GET /feeds/{token}.ics を処理する（webcal://で購読される読み取り専用フィード）
+// If-None-Match / If-Modified-Sinceが現在の版と一致する場合は304を返す
+func (s *Server) ServeFeed(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	token := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, feedPathPrefix), ".ics")
+	cal, err := s.storage.GetCalendarByFeedToken(token)
+	if err != nil {
+		http.Error(w, "feed not found", http.StatusNotFound)
+		return
+	}
+
+	events, err := s.storage.ListCalendarEvents(cal.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	seq, changedAt, err := s.storage.LatestChangeTime(cal.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	vcal := ical.CalendarComponent(cal, events)
+	vcal.Add("REFRESH-INTERVAL", ical.TypeDuration, feedRefreshInterval, ical.Param{Name: "VALUE", Value: string(ical.TypeDuration)})
+	vcal.Add("X-PUBLISHED-TTL", ical.TypeDuration, feedRefreshInterval)
+
+	fromYear, toYear := ical.TimezoneYears(events)
+	modTime, etag := feedVersion(cal, seq, changedAt, fromYear, toYear, time.Now())
+	w.Header().Set("Content-Type", icsContentType)
+	w.Header().Set("ETag", etag)
+	// キャッシュしてよいが、利用前に必ず再検証させる
+	w.Header().Set("Cache-Control", "private, no-cache")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+
+	// 条件付きリクエストの判定と304の応答はServeContentに任せる
+	http.ServeContent(w, r, "", modTime, bytes.NewReader(ical.Marshal(vcal)))
+}
+
+// feedVersion はフィードのLast-ModifiedとETagを返す
+// イベントの削除でも進むよう、カレンダーのUpdatedAtと最新の変更履歴（番号seqと日時changedAt）から求める
+// VTIMEZONEに含める年の範囲（fromYear〜toYear）は年が変わると広がるため、ETagに含め、
+// Last-Modifiedもnowの年の初めより前にしない
+func feedVersion(cal *models.Calendar, seq int64, changedAt time.Time, fromYear, toYear int, now time.Time) (time.Time, string) {
+	latest := cal.UpdatedAt
+	if changedAt.After(latest) {
+		latest = changedAt
+	}
+	if newYear := time.Date(now.Year(), 1, 1, 0, 0, 0, 0, now.Location()); latest.Before(newYear) {
+		latest = newYear
+	}
+
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\n%s\n%d\n%d-%d\n", cal.ID, cal.UpdatedAt.UTC().Format(time.RFC3339), seq, fromYear, toYear)
+	return latest, `"` + hex.EncodeToString(h.Sum(nil)[:16]) + `"`
+}
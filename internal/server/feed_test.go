@@ -0,0 +1,44 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/recurrence-scheduler/internal/models"
+)
+
+func TestFeedVersion(t *testing.T) {
+	updated := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	cal := models.NewCalendar("test", "", "Asia/Tokyo")
+	cal.UpdatedAt = updated
+	now := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	modTime, etag := feedVersion(cal, 3, updated.Add(time.Hour), 2025, 2025, now)
+	if !modTime.Equal(updated.Add(time.Hour)) {
+		t.Errorf("Last-Modified = %v, want the latest change", modTime)
+	}
+
+	tests := []struct {
+		name             string
+		seq              int64
+		fromYear, toYear int
+	}{
+		{name: "new change", seq: 4, fromYear: 2025, toYear: 2025},
+		// イベントが変わらなくても、年が変わるとVTIMEZONEの範囲が広がり本文が変わる
+		{name: "VTIMEZONE range grows", seq: 3, fromYear: 2025, toYear: 2026},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, other := feedVersion(cal, tt.seq, updated, tt.fromYear, tt.toYear, now); other == etag {
+				t.Errorf("ETag did not change: %s", other)
+			}
+		})
+	}
+
+	// If-Modified-Sinceだけで再検証するクライアントにも、年が変わった後は本文を返す
+	nextYear := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	modTime, _ = feedVersion(cal, 3, updated, 2025, 2026, nextYear)
+	if want := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC); !modTime.Equal(want) {
+		t.Errorf("Last-Modified = %v, want %v", modTime, want)
+	}
+}
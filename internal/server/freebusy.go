@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/recurrence-scheduler/internal/freebusy"
+	"github.com/recurrence-scheduler/internal/ical"
+	pb "github.com/recurrence-scheduler/proto/scheduler/v1"
+)
+
+const (
+	// maxFreeBusyCalendars は1回の問い合わせで指定できるカレンダーの上限
+	maxFreeBusyCalendars = 50
+	// maxFreeBusyWindow は問い合わせる期間の上限。繰り返しイベントの展開量を抑える
+	maxFreeBusyWindow = 366 * 24 * time.Hour
+)
+
+// QueryFreeBusy は複数のカレンダーについて、期間内で予定が入っている区間をカレンダーごとに返す
+// 繰り返しイベントはEXDATEとオーバーライドを反映して展開し、重なる区間はまとめる
+// イベントのタイトルや説明は返さない
+func (s *Server) QueryFreeBusy(ctx context.Context, req *pb.QueryFreeBusyRequest) (*pb.QueryFreeBusyResponse, error) {
+	start, end, err := parseWindow(req.Start, req.End, maxFreeBusyWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	calendars, err := s.busyCalendars(req.CalendarIds, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.QueryFreeBusyResponse{
+		Ical: string(ical.Marshal(ical.FreeBusyCalendar(start, end, calendars))),
+	}
+	for _, cb := range calendars {
+		resp.Calendars = append(resp.Calendars, &pb.CalendarFreeBusy{
+			CalendarId: cb.Calendar.ID,
+			Busy:       intervalsToProto(cb.Busy),
+		})
+	}
+	return resp, nil
+}
+
+// busyCalendars は各カレンダーの期間内の予定が入っている区間を、指定された順に返す
+// 重複して指定されたカレンダーは1つにまとめる
+func (s *Server) busyCalendars(calendarIDs []string, start, end time.Time) ([]freebusy.CalendarBusy, error) {
+	if len(calendarIDs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "calendar_ids is required")
+	}
+	if len(calendarIDs) > maxFreeBusyCalendars {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d calendar_ids can be queried at once", maxFreeBusyCalendars)
+	}
+
+	var calendars []freebusy.CalendarBusy
+	seen := make(map[string]bool, len(calendarIDs))
+	for _, id := range calendarIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		cal, err := s.storage.GetCalendar(id)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "calendar not found: %s", id)
+		}
+		instances, err := s.expandCalendar(cal.ID, start, end)
+		if err != nil {
+			return nil, err
+		}
+		calendars = append(calendars, freebusy.CalendarBusy{Calendar: cal, Busy: freebusy.Busy(instances, start, end)})
+	}
+	return calendars, nil
+}
+
+// parseWindow は問い合わせ期間を解析し、開始が終了より前で長さが上限以内であることを確認する
+func parseWindow(startStr, endStr string, maxWindow time.Duration) (start, end time.Time, err error) {
+	start, err = parseTime(startStr)
+	if err != nil {
+		return time.Time{}, time.Time{}, status.Error(codes.InvalidArgument, "invalid start time")
+	}
+	end, err = parseTime(endStr)
+	if err != nil {
+		return time.Time{}, time.Time{}, status.Error(codes.InvalidArgument, "invalid end time")
+	}
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, status.Error(codes.InvalidArgument, "start must be before end")
+	}
+	if end.Sub(start) > maxWindow {
+		return time.Time{}, time.Time{}, status.Errorf(codes.InvalidArgument, "the window must not be longer than %d days", int(maxWindow.Hours()/24))
+	}
+	return start, end, nil
+}
+
+// intervalsToProto は区間をUTCのRFC3339形式で表したprotoに変換する
+func intervalsToProto(intervals []freebusy.Interval) []*pb.TimeInterval {
+	result := make([]*pb.TimeInterval, len(intervals))
+	for i, iv := range intervals {
+		result[i] = &pb.TimeInterval{
+			Start: iv.Start.UTC().Format(time.RFC3339),
+			End:   iv.End.UTC().Format(time.RFC3339),
+		}
+	}
+	return result
+}
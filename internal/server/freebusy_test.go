@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/recurrence-scheduler/internal/models"
+	pb "github.com/recurrence-scheduler/proto/scheduler/v1"
+)
+
+func TestQueryFreeBusy(t *testing.T) {
+	s, st, cal := newTestServer(t)
+	other := models.NewCalendar("other", "", "UTC")
+	if err := st.CreateCalendar(other); err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	create := func(e *models.Event) {
+		t.Helper()
+		if err := st.CreateEvent(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// cal: 毎日9:00-10:00。7日は除外し、8日は11:00に移動する
+	daily := models.NewEvent(cal.ID, "standup", "", start, start.Add(time.Hour), "FREQ=DAILY;COUNT=3", "UTC")
+	daily.ExDates = []time.Time{start.AddDate(0, 0, 1)}
+	create(daily)
+	override := models.NewEvent(cal.ID, "standup", "", start.AddDate(0, 0, 2).Add(2*time.Hour), start.AddDate(0, 0, 2).Add(3*time.Hour), "", "UTC")
+	override.RecurringEventID = daily.ID
+	override.RecurrenceID = start.AddDate(0, 0, 2)
+	create(override)
+
+	// other: 重なる予定と隣接する予定は1つの区間にまとめる
+	create(models.NewEvent(other.ID, "a", "", start, start.Add(time.Hour), "", "UTC"))
+	create(models.NewEvent(other.ID, "b", "", start.Add(30*time.Minute), start.Add(90*time.Minute), "", "UTC"))
+	create(models.NewEvent(other.ID, "c", "", start.Add(90*time.Minute), start.Add(2*time.Hour), "", "UTC"))
+
+	resp, err := s.QueryFreeBusy(context.Background(), &pb.QueryFreeBusyRequest{
+		CalendarIds: []string{cal.ID, other.ID, cal.ID},
+		Start:       "2025-01-06T00:00:00Z",
+		End:         "2025-01-09T00:00:00Z",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		cal.ID:   "[2025-01-06T09:00:00Z/2025-01-06T10:00:00Z 2025-01-08T11:00:00Z/2025-01-08T12:00:00Z]",
+		other.ID: "[2025-01-06T09:00:00Z/2025-01-06T11:00:00Z]",
+	}
+	if len(resp.Calendars) != len(want) {
+		t.Fatalf("got %d calendars, want %d", len(resp.Calendars), len(want))
+	}
+	for _, c := range resp.Calendars {
+		var got []string
+		for _, iv := range c.Busy {
+			got = append(got, iv.Start+"/"+iv.End)
+		}
+		if fmt.Sprint(got) != want[c.CalendarId] {
+			t.Errorf("busy for %s = %v, want %s", c.CalendarId, got, want[c.CalendarId])
+		}
+	}
+
+	if !strings.Contains(resp.Ical, "BEGIN:VFREEBUSY") || !strings.Contains(resp.Ical, "FREEBUSY;FBTYPE=BUSY:20250106T090000Z/20250106T110000Z") {
+		t.Errorf("unexpected VFREEBUSY:\n%s", resp.Ical)
+	}
+	if strings.Contains(resp.Ical, "standup") {
+		t.Errorf("VFREEBUSY exposes event details:\n%s", resp.Ical)
+	}
+}
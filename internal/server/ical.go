@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/recurrence-scheduler/internal/ical"
+	pb "github.com/recurrence-scheduler/proto/scheduler/v1"
+)
+
+// icsContentType はiCalendar形式のContent-Type
+const icsContentType = "text/calendar; charset=utf-8"
+
+// ExportCalendar はカレンダーをiCalendar形式（formatの指定によりjCal・xCal形式）で書き出す
+func (s *Server) ExportCalendar(ctx context.Context, req *pb.ExportCalendarRequest) (*pb.ExportCalendarResponse, error) {
+	vcal, err := s.calendarComponent(req.CalendarId)
+	if err != nil {
+		return nil, err
+	}
+
+	data, contentType, err := marshalCalendar(vcal, req.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ExportCalendarResponse{Data: string(data), ContentType: contentType}, nil
+}
+
+// marshalCalendar はVCALENDARを指定された形式に変換し、そのContent-Typeとともに返す
+func marshalCalendar(vcal *ical.Component, format pb.CalendarFormat) ([]byte, string, error) {
+	switch format {
+	case pb.CalendarFormat_CALENDAR_FORMAT_UNSPECIFIED, pb.CalendarFormat_CALENDAR_FORMAT_ICALENDAR:
+		return ical.Marshal(vcal), icsContentType, nil
+	case pb.CalendarFormat_CALENDAR_FORMAT_JCAL:
+		data, err := ical.MarshalJCal(vcal)
+		if err != nil {
+			return nil, "", status.Error(codes.Internal, err.Error())
+		}
+		return data, ical.JCalContentType, nil
+	case pb.CalendarFormat_CALENDAR_FORMAT_XCAL:
+		data, err := ical.MarshalXCal(vcal)
+		if err != nil {
+			return nil, "", status.Error(codes.Internal, err.Error())
+		}
+		return data, ical.XCalContentType, nil
+	}
+	return nil, "", status.Errorf(codes.InvalidArgument, "unsupported format: %v", format)
+}
+
+// exportICS はカレンダーとそのすべてのイベントをiCalendar形式で出力する
+func (s *Server) exportICS(calendarID string) ([]byte, error) {
+	vcal, err := s.calendarComponent(calendarID)
+	if err != nil {
+		return nil, err
+	}
+	return ical.Marshal(vcal), nil
+}
+
+// calendarComponent はカレンダーとそのすべてのイベントをVCALENDARに変換する
+func (s *Server) calendarComponent(calendarID string) (*ical.Component, error) {
+	cal, err := s.storage.GetCalendar(calendarID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "calendar not found")
+	}
+
+	events, err := s.storage.ListCalendarEvents(cal.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return ical.CalendarComponent(cal, events), nil
+}
+
+// ServeICS は GET /api/v1/calendars/{id}.ics を処理する
+func (s *Server) ServeICS(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/v1/calendars/"), ".ics")
+	data, err := s.exportICS(id)
+	if err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", icsContentType)
+	w.Header().Set("Content-Disposition", `attachment; filename="`+id+`.ics"`)
+	w.Write(data)
+}
+
+// writeHTTPError はgRPCのステータスエラーを対応するHTTPステータスで返す
+func writeHTTPError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	code := http.StatusInternalServerError
+	switch st.Code() {
+	case codes.NotFound:
+		code = http.StatusNotFound
+	case codes.InvalidArgument:
+		code = http.StatusBadRequest
+	case codes.FailedPrecondition:
+		code = http.StatusPreconditionFailed
+	case codes.PermissionDenied:
+		code = http.StatusForbidden
+	case codes.Unauthenticated:
+		code = http.StatusUnauthorized
+	}
+	http.Error(w, st.Message(), code)
+}
@@ -0,0 +1,315 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/recurrence-scheduler/internal/ical"
+	"github.com/recurrence-scheduler/internal/models"
+	"github.com/recurrence-scheduler/internal/recurrence"
+	pb "github.com/recurrence-scheduler/proto/scheduler/v1"
+)
+
+// maxImportSize はアップロードを受け付けるiCalendarデータの上限
+const maxImportSize = 10 << 20
+
+// ImportCalendar はiCalendar形式のデータからイベントを取り込む
+// 同じUID（オーバーライドはUIDとRECURRENCE-ID）のイベントが既にあれば更新し、VEVENTごとの結果を返す
+func (s *Server) ImportCalendar(ctx context.Context, req *pb.ImportCalendarRequest) (*pb.ImportCalendarResponse, error) {
+	if req.Data == "" {
+		return nil, status.Error(codes.InvalidArgument, "data is required")
+	}
+	return s.importCalendar(req.CalendarId, []byte(req.Data), req.Format)
+}
+
+// parseCalendar は指定された形式でカレンダーデータを解析する
+// 形式が指定されていなければ、JSONの配列で始まるデータをjCal、XMLで始まるデータをxCalとして扱う
+func parseCalendar(data []byte, format pb.CalendarFormat) (*ical.Component, error) {
+	if format == pb.CalendarFormat_CALENDAR_FORMAT_UNSPECIFIED {
+		trimmed := bytes.TrimSpace(data)
+		switch {
+		case bytes.HasPrefix(trimmed, []byte("[")):
+			format = pb.CalendarFormat_CALENDAR_FORMAT_JCAL
+		case bytes.HasPrefix(trimmed, []byte("<")):
+			format = pb.CalendarFormat_CALENDAR_FORMAT_XCAL
+		}
+	}
+
+	switch format {
+	case pb.CalendarFormat_CALENDAR_FORMAT_JCAL:
+		return ical.ParseJCal(data)
+	case pb.CalendarFormat_CALENDAR_FORMAT_XCAL:
+		return ical.ParseXCal(data)
+	default:
+		return ical.Parse(bytes.NewReader(data))
+	}
+}
+
+// importCalendar はVCALENDARを解析し、UIDごとに繰り返しの親イベントとオーバーライドをまとめて保存する
+func (s *Server) importCalendar(calendarID string, data []byte, format pb.CalendarFormat) (*pb.ImportCalendarResponse, error) {
+	cal, err := s.storage.GetCalendar(calendarID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "calendar not found")
+	}
+
+	vcal, err := parseCalendar(data, format)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid iCalendar data: "+err.Error())
+	}
+	if vcal.Name != "VCALENDAR" {
+		return nil, status.Error(codes.InvalidArgument, "invalid iCalendar data: expected VCALENDAR, got "+vcal.Name)
+	}
+
+	vevents, events, warnings, errs := ical.Events(vcal, cal.Timezone)
+	results := make([]*pb.ImportResult, len(vevents))
+
+	// VEVENTをUIDごとにまとめる（UIDは最初に現れた順に保存する）
+	var uids []string
+	groups := make(map[string]*importGroup)
+	for i, vevent := range vevents {
+		if errs[i] != nil {
+			results[i] = rejected(vevent.Value("UID"), vevent.Value("RECURRENCE-ID"), errs[i].Error())
+			continue
+		}
+
+		uid := events[i].UID
+		group, ok := groups[uid]
+		if !ok {
+			group = &importGroup{uid: uid, master: -1}
+			groups[uid] = group
+			uids = append(uids, uid)
+		}
+		switch {
+		case !events[i].RecurrenceID.IsZero():
+			group.overrides = append(group.overrides, i)
+		case group.master >= 0:
+			results[i] = rejected(uid, "", "duplicate VEVENT without RECURRENCE-ID for this UID")
+		default:
+			group.master = i
+		}
+	}
+
+	for _, uid := range uids {
+		s.importSeries(cal, groups[uid], events, results)
+	}
+
+	resp := &pb.ImportCalendarResponse{Results: results}
+	for i, result := range results {
+		switch result.Status {
+		case pb.ImportResult_CREATED:
+			resp.Created++
+		case pb.ImportResult_UPDATED:
+			resp.Updated++
+		case pb.ImportResult_REJECTED:
+			resp.Rejected++
+		}
+		if result.Status != pb.ImportResult_REJECTED && warnings[i] != "" {
+			result.Message = warnings[i]
+		}
+	}
+	return resp, nil
+}
+
+// importGroup は同じUIDを持つVEVENTの位置
+type importGroup struct {
+	uid       string
+	master    int // 繰り返しの親または単発のイベントの位置（データに含まれない場合は-1）
+	overrides []int
+}
+
+// importSeries はUIDが同じ親イベントとオーバーライドを1トランザクションで保存し、resultsに結果を書き込む
+// データに親イベントが含まれる場合、既存のオーバーライドはデータに含まれるものだけに置き換える
+// オーバーライドだけの場合は既存の親イベントに対して、含まれるオーバーライドだけを追加・更新する
+func (s *Server) importSeries(cal *models.Calendar, group *importGroup, events []*models.Event, results []*pb.ImportResult) {
+	rejectAll := func(message string) {
+		for _, i := range append([]int{group.master}, group.overrides...) {
+			if i >= 0 && results[i] == nil {
+				results[i] = rejected(group.uid, formatRecurrenceID(events[i]), message)
+			}
+		}
+	}
+
+	master, err := s.storage.GetEventByUID(cal.ID, group.uid)
+	exists := err == nil
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		rejectAll(err.Error())
+		return
+	}
+
+	var existingOverrides []*models.Event
+	if exists {
+		existingOverrides, err = s.storage.ListOverrides(master.ID)
+		if err != nil {
+			rejectAll(err.Error())
+			return
+		}
+	}
+	existing := make(map[string]*models.Event)
+	for _, override := range existingOverrides {
+		existing[override.ID] = override
+	}
+
+	if group.master >= 0 {
+		imported := events[group.master]
+		if err := validateRRule(imported.RRule, imported.DTStart); err != nil {
+			rejectAll(status.Convert(err).Message())
+			return
+		}
+		if !exists {
+			master = models.NewEvent(cal.ID, "", "", time.Time{}, time.Time{}, "", "")
+		}
+		ical.Apply(master, imported, s.storage)
+	} else if !exists {
+		rejectAll("no recurring event with this UID")
+		return
+	}
+
+	var overrides []*models.Event
+	saved := make(map[int]*models.Event)
+	for _, i := range group.overrides {
+		imported := events[i]
+		recurrenceID := formatRecurrenceID(imported)
+
+		ok, err := recurrence.IsOccurrence(master, imported.RecurrenceID)
+		if err != nil {
+			results[i] = rejected(group.uid, recurrenceID, err.Error())
+			continue
+		}
+		if !ok {
+			results[i] = rejected(group.uid, recurrenceID, "RECURRENCE-ID is not an occurrence of the recurring event")
+			continue
+		}
+
+		override := recurrence.NewOverride(master, imported.RecurrenceID)
+		if slices.ContainsFunc(overrides, func(o *models.Event) bool { return o.ID == override.ID }) {
+			results[i] = rejected(group.uid, recurrenceID, "duplicate RECURRENCE-ID")
+			continue
+		}
+		if previous, ok := existing[override.ID]; ok {
+			override.CreatedAt = previous.CreatedAt
+		}
+		ical.Apply(override, imported, s.storage)
+		overrides = append(overrides, override)
+		saved[i] = override
+	}
+
+	// オーバーライドだけを取り込む場合は、含まれていない既存のオーバーライドを残す
+	if group.master < 0 {
+		for _, override := range existingOverrides {
+			if !slices.ContainsFunc(overrides, func(o *models.Event) bool { return o.ID == override.ID }) {
+				overrides = append(overrides, override)
+			}
+		}
+	}
+
+	if err := s.storage.SaveSeries(master, overrides); err != nil {
+		rejectAll(err.Error())
+		return
+	}
+
+	if group.master >= 0 {
+		results[group.master] = importedResult(master, "", exists)
+	}
+	for i, override := range saved {
+		_, updated := existing[override.ID]
+		results[i] = importedResult(override, formatRecurrenceID(events[i]), updated)
+	}
+}
+
+// formatRecurrenceID は取り込み結果に表示するRECURRENCE-IDを返す（親イベントの場合は空）
+func formatRecurrenceID(event *models.Event) string {
+	if event.RecurrenceID.IsZero() {
+		return ""
+	}
+	return event.RecurrenceID.UTC().Format(time.RFC3339)
+}
+
+// importedResult は保存したイベントの取り込み結果を作成する
+func importedResult(event *models.Event, recurrenceID string, updated bool) *pb.ImportResult {
+	result := &pb.ImportResult{
+		Uid:          ical.UID(event),
+		RecurrenceId: recurrenceID,
+		Status:       pb.ImportResult_CREATED,
+		EventId:      event.ID,
+	}
+	if updated {
+		result.Status = pb.ImportResult_UPDATED
+	}
+	return result
+}
+
+// rejected は取り込めなかったVEVENTの結果を作成する
+func rejected(uid, recurrenceID, message string) *pb.ImportResult {
+	return &pb.ImportResult{
+		Uid:          uid,
+		RecurrenceId: recurrenceID,
+		Status:       pb.ImportResult_REJECTED,
+		Message:      message,
+	}
+}
+
+// ServeImport は POST /api/v1/calendars/{id}/import を処理する
+// multipart/form-dataのfileフィールド、またはtext/calendar（application/calendar+json、application/calendar+xml）の本文をそのまま受け付ける
+func (s *Server) ServeImport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/v1/calendars/"), "/import")
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportSize)
+
+	var body io.Reader = r.Body
+	contentType := r.Header.Get("Content-Type")
+	if strings.HasPrefix(contentType, "multipart/form-data") {
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			http.Error(w, "file is required", http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+		body = file
+		contentType = header.Header.Get("Content-Type")
+	}
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	format := pb.CalendarFormat_CALENDAR_FORMAT_UNSPECIFIED
+	switch mediaType, _, _ := mime.ParseMediaType(contentType); mediaType {
+	case ical.JCalContentType:
+		format = pb.CalendarFormat_CALENDAR_FORMAT_JCAL
+	case ical.XCalContentType:
+		format = pb.CalendarFormat_CALENDAR_FORMAT_XCAL
+	}
+
+	resp, err := s.importCalendar(id, data, format)
+	if err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+
+	// gRPC-Gatewayの既定の出力形式に合わせる
+	out, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(resp)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(out)
+}
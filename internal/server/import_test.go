@@ -0,0 +1,215 @@
+package server
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/recurrence-scheduler/internal/ical"
+	"github.com/recurrence-scheduler/internal/models"
+	"github.com/recurrence-scheduler/internal/storage"
+	pb "github.com/recurrence-scheduler/proto/scheduler/v1"
+)
+
+// newTestServer は一時ディレクトリのSQLiteを使うサーバーと、タイムゾーンがUTCのカレンダーを作成する
+func newTestServer(t *testing.T) (*Server, *storage.SQLiteStorage, *models.Calendar) {
+	t.Helper()
+	st, err := storage.NewSQLiteStorage(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { st.Close() })
+
+	cal := models.NewCalendar("test", "", "UTC")
+	if err := st.CreateCalendar(cal); err != nil {
+		t.Fatal(err)
+	}
+	return NewServer(st), st, cal
+}
+
+// vcalendar はコンポーネントをVCALENDARで囲む
+func vcalendar(components ...string) string {
+	lines := []string{"BEGIN:VCALENDAR", "VERSION:2.0", "PRODID:-//test//test//EN"}
+	lines = append(lines, components...)
+	lines = append(lines, "END:VCALENDAR")
+	return strings.Join(lines, "\r\n") + "\r\n"
+}
+
+func vevent(props ...string) string {
+	return strings.Join(append(append([]string{"BEGIN:VEVENT"}, props...), "END:VEVENT"), "\r\n")
+}
+
+const (
+	customZone = "BEGIN:VTIMEZONE\r\nTZID:Customized Time Zone\r\nBEGIN:STANDARD\r\nDTSTART:16010101T000000\r\n" +
+		"TZOFFSETFROM:+0900\r\nTZOFFSETTO:+0900\r\nEND:STANDARD\r\nEND:VTIMEZONE"
+	displayAlarm = "BEGIN:VALARM\r\nACTION:DISPLAY\r\nTRIGGER:-PT15M\r\nDESCRIPTION:soon\r\nEND:VALARM"
+)
+
+var (
+	daily = []string{"UID:daily", "SUMMARY:standup", "DTSTART:20250106T090000Z", "DTEND:20250106T091500Z", "RRULE:FREQ=DAILY;COUNT=5"}
+	moved = []string{"UID:daily", "SUMMARY:moved", "RECURRENCE-ID:20250107T090000Z", "DTSTART:20250107T100000Z", "DTEND:20250107T101500Z"}
+	later = []string{"UID:daily", "SUMMARY:later", "RECURRENCE-ID:20250108T090000Z", "DTSTART:20250108T110000Z", "DTEND:20250108T111500Z"}
+)
+
+func TestImportCalendarDeduplication(t *testing.T) {
+	created, updated, rejected := pb.ImportResult_CREATED, pb.ImportResult_UPDATED, pb.ImportResult_REJECTED
+
+	tests := []struct {
+		name    string
+		imports []string
+		// 最後の取り込みの結果
+		want []pb.ImportResult_Status
+		// 最後の取り込みの後にカレンダーにあるイベント（オーバーライドを含む）の数
+		wantEvents int
+		check      func(t *testing.T, events []*models.Event, results []*pb.ImportResult)
+	}{
+		{
+			name:       "re-import updates the event with the same UID",
+			imports:    []string{vcalendar(vevent(daily...)), vcalendar(vevent(daily...))},
+			want:       []pb.ImportResult_Status{updated},
+			wantEvents: 1,
+		},
+		{
+			name:       "re-import updates existing overrides",
+			imports:    []string{vcalendar(vevent(daily...), vevent(moved...)), vcalendar(vevent(daily...), vevent(moved...))},
+			want:       []pb.ImportResult_Status{updated, updated},
+			wantEvents: 2,
+		},
+		{
+			name:       "overrides missing from a re-import are deleted",
+			imports:    []string{vcalendar(vevent(daily...), vevent(moved...), vevent(later...)), vcalendar(vevent(daily...), vevent(later...))},
+			want:       []pb.ImportResult_Status{updated, updated},
+			wantEvents: 2,
+			check: func(t *testing.T, events []*models.Event, _ []*pb.ImportResult) {
+				for _, e := range events {
+					if e.Title == "moved" {
+						t.Errorf("override %s was not deleted", e.ID)
+					}
+				}
+			},
+		},
+		{
+			name:       "overrides without their main VEVENT keep the other overrides",
+			imports:    []string{vcalendar(vevent(daily...), vevent(moved...)), vcalendar(vevent(later...))},
+			want:       []pb.ImportResult_Status{created},
+			wantEvents: 3,
+		},
+		{
+			name:       "overrides without a recurring event are rejected",
+			imports:    []string{vcalendar(vevent(moved...))},
+			want:       []pb.ImportResult_Status{rejected},
+			wantEvents: 0,
+		},
+		{
+			name:       "duplicate main VEVENT in one file",
+			imports:    []string{vcalendar(vevent(daily...), vevent(daily...))},
+			want:       []pb.ImportResult_Status{created, rejected},
+			wantEvents: 1,
+		},
+		{
+			name:       "duplicate RECURRENCE-ID in one file",
+			imports:    []string{vcalendar(vevent(daily...), vevent(moved...), vevent(moved...))},
+			want:       []pb.ImportResult_Status{created, created, rejected},
+			wantEvents: 2,
+		},
+		{
+			name:       "identical VALARMs are merged",
+			imports:    []string{vcalendar(vevent(append(daily, displayAlarm, displayAlarm)...))},
+			want:       []pb.ImportResult_Status{created},
+			wantEvents: 1,
+			check: func(t *testing.T, events []*models.Event, _ []*pb.ImportResult) {
+				if n := len(events[0].Reminders); n != 1 {
+					t.Errorf("got %d reminders, want 1", n)
+				}
+			},
+		},
+		{
+			name:       "unknown TZID without VTIMEZONE is rejected",
+			imports:    []string{vcalendar(vevent("UID:tz", "SUMMARY:tz", "DTSTART;TZID=Nowhere/Special:20250106T090000", "DTEND;TZID=Nowhere/Special:20250106T100000"))},
+			want:       []pb.ImportResult_Status{rejected},
+			wantEvents: 0,
+		},
+		{
+			name:       "TZID with only a VTIMEZONE is converted and flagged",
+			imports:    []string{vcalendar(customZone, vevent("UID:tz", "SUMMARY:tz", "DTSTART;TZID=Customized Time Zone:20250106T090000", "DTEND;TZID=Customized Time Zone:20250106T100000"))},
+			want:       []pb.ImportResult_Status{created},
+			wantEvents: 1,
+			check: func(t *testing.T, events []*models.Event, results []*pb.ImportResult) {
+				if got := events[0].DTStart.UTC().Format("15:04"); got != "00:00" {
+					t.Errorf("DTSTART = %s UTC, want 00:00", got)
+				}
+				if !strings.Contains(results[0].Message, "Customized Time Zone") {
+					t.Errorf("message = %q, want a warning about the TZID", results[0].Message)
+				}
+			},
+		},
+		{
+			name:       "DATE-valued DTSTART is imported as all-day",
+			imports:    []string{vcalendar(vevent("UID:holiday", "SUMMARY:holiday", "DTSTART;VALUE=DATE:20250101"))},
+			want:       []pb.ImportResult_Status{created},
+			wantEvents: 1,
+			check: func(t *testing.T, events []*models.Event, _ []*pb.ImportResult) {
+				if !events[0].AllDay {
+					t.Error("event is not all-day")
+				}
+				if d := events[0].DTEnd.Sub(events[0].DTStart).Hours(); d != 24 {
+					t.Errorf("duration = %vh, want 24h", d)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, st, cal := newTestServer(t)
+
+			var resp *pb.ImportCalendarResponse
+			for _, data := range tt.imports {
+				var err error
+				resp, err = s.importCalendar(cal.ID, []byte(data), pb.CalendarFormat_CALENDAR_FORMAT_ICALENDAR)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if len(resp.Results) != len(tt.want) {
+				t.Fatalf("got %d results, want %d", len(resp.Results), len(tt.want))
+			}
+			for i, result := range resp.Results {
+				if result.Status != tt.want[i] {
+					t.Errorf("result %d: status = %v (%s), want %v", i, result.Status, result.Message, tt.want[i])
+				}
+			}
+
+			events, err := st.ListCalendarEvents(cal.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(events) != tt.wantEvents {
+				t.Fatalf("got %d events, want %d", len(events), tt.wantEvents)
+			}
+			if tt.check != nil {
+				tt.check(t, events, resp.Results)
+			}
+		})
+	}
+}
+
+func TestExportAllDayEvent(t *testing.T) {
+	s, st, cal := newTestServer(t)
+	data := vcalendar(vevent("UID:holiday", "SUMMARY:holiday", "DTSTART;VALUE=DATE:20250101", "RRULE:FREQ=YEARLY"))
+	if _, err := s.importCalendar(cal.ID, []byte(data), pb.CalendarFormat_CALENDAR_FORMAT_ICALENDAR); err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := st.ListCalendarEvents(cal.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := string(ical.Marshal(ical.CalendarComponent(cal, events)))
+	for _, want := range []string{"DTSTART;VALUE=DATE:20250101", "DTEND;VALUE=DATE:20250102"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("export does not contain %q:\n%s", want, out)
+		}
+	}
+}
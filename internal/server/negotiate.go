@@ -0,0 +1,178 @@
+package server
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/recurrence-scheduler/internal/ical"
+	"github.com/recurrence-scheduler/internal/models"
+	pb "github.com/recurrence-scheduler/proto/scheduler/v1"
+)
+
+// Negotiate はAcceptヘッダーでjCal（RFC 7265）またはxCal（RFC 6321）を求めるREST APIのリクエストに、その形式で応答する
+// 対応するのはカレンダー・イベント・展開したインスタンスを返すルートで、それ以外はnextに渡す
+func (s *Server) Negotiate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		format := negotiateFormat(r.Header.Get("Accept"))
+		if format == pb.CalendarFormat_CALENDAR_FORMAT_UNSPECIFIED {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		vcal, ok, err := s.calendarRoute(r)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if err != nil {
+			writeHTTPError(w, err)
+			return
+		}
+
+		data, contentType, err := marshalCalendar(vcal, format)
+		if err != nil {
+			writeHTTPError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Vary", "Accept")
+		w.Write(data)
+	})
+}
+
+// negotiateFormat はAcceptヘッダーで最も優先度の高いメディアタイプに対応する形式を返す
+// jCal・xCal以外（application/jsonなど）が優先される場合はUNSPECIFIEDを返す
+func negotiateFormat(accept string) pb.CalendarFormat {
+	var best string
+	bestQ := 0.0
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if v, err := strconv.ParseFloat(params["q"], 64); err == nil {
+			q = v
+		}
+		if q > bestQ {
+			best, bestQ = mediaType, q
+		}
+	}
+
+	switch best {
+	case ical.JCalContentType:
+		return pb.CalendarFormat_CALENDAR_FORMAT_JCAL
+	case ical.XCalContentType:
+		return pb.CalendarFormat_CALENDAR_FORMAT_XCAL
+	}
+	return pb.CalendarFormat_CALENDAR_FORMAT_UNSPECIFIED
+}
+
+// calendarRoute はリクエストのルートに対応するVCALENDARを作成する
+// 対応しないルートの場合はokにfalseを返す
+func (s *Server) calendarRoute(r *http.Request) (vcal *ical.Component, ok bool, err error) {
+	path, found := strings.CutPrefix(r.URL.Path, "/api/v1/")
+	if !found {
+		return nil, false, nil
+	}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	query := r.URL.Query()
+
+	switch {
+	// GET /api/v1/calendars/{id}
+	case r.Method == http.MethodGet && len(segments) == 2 && segments[0] == "calendars":
+		vcal, err = s.calendarComponent(segments[1])
+		return vcal, true, err
+
+	// GET /api/v1/calendars/{id}/occurrences
+	case r.Method == http.MethodGet && len(segments) == 3 && segments[0] == "calendars" && segments[2] == "occurrences":
+		instances, _, err := s.listOccurrences(&pb.ListOccurrencesRequest{
+			CalendarId: segments[1],
+			Start:      query.Get("start"),
+			End:        query.Get("end"),
+			PageSize:   queryInt32(query.Get("page_size")),
+			PageToken:  query.Get("page_token"),
+		})
+		if err != nil {
+			return nil, true, err
+		}
+		return ical.CalendarComponent(nil, instances), true, nil
+
+	// GET /api/v1/events
+	case r.Method == http.MethodGet && len(segments) == 1 && segments[0] == "events":
+		events, _, err := s.listEvents(&pb.ListEventsRequest{
+			CalendarId: query.Get("calendar_id"),
+			Start:      query.Get("start"),
+			End:        query.Get("end"),
+			PageSize:   queryInt32(query.Get("page_size")),
+			PageToken:  query.Get("page_token"),
+		})
+		if err != nil {
+			return nil, true, err
+		}
+		events, err = s.withOverrides(events)
+		if err != nil {
+			return nil, true, err
+		}
+		return ical.CalendarComponent(nil, events), true, nil
+
+	// GET /api/v1/events/{id}
+	case r.Method == http.MethodGet && len(segments) == 2 && segments[0] == "events":
+		event, err := s.storage.GetEvent(segments[1])
+		if err != nil {
+			return nil, true, status.Error(codes.NotFound, "event not found")
+		}
+		events, err := s.withOverrides([]*models.Event{event})
+		if err != nil {
+			return nil, true, err
+		}
+		return ical.ObjectComponent(events), true, nil
+
+	// POST /api/v1/events/{id}/expand
+	case r.Method == http.MethodPost && len(segments) == 3 && segments[0] == "events" && segments[2] == "expand":
+		var body struct {
+			Start string `json:"start"`
+			End   string `json:"end"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			return nil, true, status.Error(codes.InvalidArgument, "invalid request body")
+		}
+		instances, err := s.expandRecurrence(&pb.ExpandRecurrenceRequest{EventId: segments[1], Start: body.Start, End: body.End})
+		if err != nil {
+			return nil, true, err
+		}
+		return ical.ObjectComponent(instances), true, nil
+	}
+
+	return nil, false, nil
+}
+
+// withOverrides は繰り返しイベントの後ろにそのオーバーライドを加えたリストを返す
+// iCalendarの各形式ではオーバーライドを同じUIDのRECURRENCE-ID付きVEVENTとして表すため
+func (s *Server) withOverrides(events []*models.Event) ([]*models.Event, error) {
+	var result []*models.Event
+	for _, event := range events {
+		result = append(result, event)
+		if event.IsOverride() {
+			continue
+		}
+		overrides, err := s.storage.ListOverrides(event.ID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		result = append(result, overrides...)
+	}
+	return result, nil
+}
+
+// queryInt32 はクエリパラメータを整数として読む（不正な値は0）
+func queryInt32(s string) int32 {
+	n, _ := strconv.ParseInt(s, 10, 32)
+	return int32(n)
+}
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/recurrence-scheduler/internal/trigger"
+	pb "github.com/recurrence-scheduler/proto/scheduler/v1"
+)
+
+// WithOccurrences はWatchOccurrencesで配るインスタンスの開始の購読元を設定する
+func (s *Server) WithOccurrences(b *trigger.Broadcaster) *Server {
+	s.occurrences = b
+	return s
+}
+
+// WatchOccurrences はカレンダーのイベントのインスタンスが開始するたびにストリームで送る
+// 接続していない間に開始したインスタンスは送らない（取りこぼせない用途にはWebhookを使う）
+func (s *Server) WatchOccurrences(req *pb.WatchOccurrencesRequest, stream pb.SchedulerService_WatchOccurrencesServer) error {
+	if s.occurrences == nil {
+		return status.Error(codes.Unavailable, "occurrence triggers are not enabled")
+	}
+	if _, err := s.storage.GetCalendar(req.CalendarId); err != nil {
+		return status.Error(codes.NotFound, "calendar not found")
+	}
+
+	firings, stop := s.occurrences.Subscribe(req.CalendarId)
+	defer stop()
+
+	lang := languageFromContext(stream.Context())
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case firing := <-firings:
+			if err := stream.Send(&pb.OccurrenceStarted{
+				FiringId:   firing.ID,
+				EventId:    firing.EventID,
+				CalendarId: firing.CalendarID,
+				Instance:   eventToProto(firing.Instance, lang),
+				FiredAt:    firing.FiredAt.UTC().Format(time.RFC3339),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/recurrence-scheduler/internal/storage"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 100
+)
+
+// pageToken はpage_tokenの中身。クライアントからは不透明な文字列として扱われる
+// 別の条件の一覧に使われたトークンを拒否できるよう、発行したときの絞り込み条件を含める
+type pageToken struct {
+	Key    string `json:"k"`
+	ID     string `json:"i"`
+	Filter string `json:"f,omitempty"`
+}
+
+// normalizePageSize はpage_sizeが未指定（0以下）なら既定値に、上限を超えていれば上限にする
+func normalizePageSize(pageSize int32) int {
+	switch {
+	case pageSize <= 0:
+		return defaultPageSize
+	case pageSize > maxPageSize:
+		return maxPageSize
+	}
+	return int(pageSize)
+}
+
+// encodePageToken は次のページの開始位置をpage_tokenにする
+func encodePageToken(cursor storage.Cursor, filter string) string {
+	data, _ := json.Marshal(pageToken{Key: cursor.Key, ID: cursor.ID, Filter: filter})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePageToken はpage_tokenを開始位置に戻す。空のトークンは先頭を表す
+func decodePageToken(token, filter string) (storage.Cursor, error) {
+	if token == "" {
+		return storage.Cursor{}, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return storage.Cursor{}, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	var t pageToken
+	if err := json.Unmarshal(data, &t); err != nil || t.ID == "" {
+		return storage.Cursor{}, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	if t.Filter != filter {
+		return storage.Cursor{}, status.Error(codes.InvalidArgument, "page_token does not match the request parameters")
+	}
+	return storage.Cursor{Key: t.Key, ID: t.ID}, nil
+}
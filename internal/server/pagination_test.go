@@ -0,0 +1,151 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/recurrence-scheduler/internal/models"
+	"github.com/recurrence-scheduler/internal/storage"
+	pb "github.com/recurrence-scheduler/proto/scheduler/v1"
+)
+
+func TestDecodePageToken(t *testing.T) {
+	cursor := storage.Cursor{Key: "2025-01-06T09:00:00Z", ID: "event-1"}
+	valid := encodePageToken(cursor, "cal|a|b")
+
+	tests := []struct {
+		name     string
+		token    string
+		filter   string
+		want     storage.Cursor
+		wantCode codes.Code
+	}{
+		{name: "empty token is the first page", token: "", filter: "cal|a|b", want: storage.Cursor{}},
+		{name: "round trip", token: valid, filter: "cal|a|b", want: cursor},
+		{name: "different filter", token: valid, filter: "cal|a|c", wantCode: codes.InvalidArgument},
+		{name: "not base64", token: "!!!", filter: "cal|a|b", wantCode: codes.InvalidArgument},
+		{name: "not JSON", token: "bm90IGpzb24", filter: "cal|a|b", wantCode: codes.InvalidArgument},
+		{name: "missing ID", token: encodePageToken(storage.Cursor{Key: "k"}, "cal|a|b"), filter: "cal|a|b", wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodePageToken(tt.token, tt.filter)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("code = %v, want %v (err: %v)", code, tt.wantCode, err)
+			}
+			if got != tt.want {
+				t.Errorf("cursor = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizePageSize(t *testing.T) {
+	tests := []struct {
+		in   int32
+		want int
+	}{
+		{0, defaultPageSize},
+		{-1, defaultPageSize},
+		{1, 1},
+		{maxPageSize, maxPageSize},
+		{maxPageSize + 1, maxPageSize},
+	}
+	for _, tt := range tests {
+		if got := normalizePageSize(tt.in); got != tt.want {
+			t.Errorf("normalizePageSize(%d) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestListEventsPages(t *testing.T) {
+	start := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		events   int
+		pageSize int32
+		// すべてのイベントを同じ時刻に開始させる
+		sameStart bool
+		// 各ページの件数（最後のページのnext_page_tokenは空）
+		wantPages []int
+	}{
+		{name: "partial last page", events: 5, pageSize: 2, wantPages: []int{2, 2, 1}},
+		{name: "exact multiple has no empty last page", events: 4, pageSize: 2, wantPages: []int{2, 2}},
+		{name: "single page", events: 2, pageSize: 5, wantPages: []int{2}},
+		{name: "no events", events: 0, pageSize: 2, wantPages: []int{0}},
+		// 同じ開始時刻のイベントはIDで並べるため、ページの境界で重複も欠落もしない
+		{name: "same start time", events: 5, pageSize: 2, sameStart: true, wantPages: []int{2, 2, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, st, cal := newTestServer(t)
+			for i := 0; i < tt.events; i++ {
+				dtStart := start.AddDate(0, 0, i)
+				if tt.sameStart {
+					dtStart = start
+				}
+				event := models.NewEvent(cal.ID, fmt.Sprintf("event %d", i), "", dtStart, dtStart.Add(time.Hour), "", "UTC")
+				if err := st.CreateEvent(event); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			req := &pb.ListEventsRequest{
+				CalendarId: cal.ID,
+				Start:      "2025-01-01T00:00:00Z",
+				End:        "2025-02-01T00:00:00Z",
+				PageSize:   tt.pageSize,
+			}
+			seen := make(map[string]bool)
+			var pages []int
+			for {
+				events, next, err := s.listEvents(req)
+				if err != nil {
+					t.Fatal(err)
+				}
+				pages = append(pages, len(events))
+				for _, e := range events {
+					if seen[e.ID] {
+						t.Errorf("event %s returned twice", e.ID)
+					}
+					seen[e.ID] = true
+				}
+				if next == "" {
+					break
+				}
+				if len(pages) > tt.events+1 {
+					t.Fatal("too many pages")
+				}
+				req.PageToken = next
+			}
+
+			if fmt.Sprint(pages) != fmt.Sprint(tt.wantPages) {
+				t.Errorf("pages = %v, want %v", pages, tt.wantPages)
+			}
+			if len(seen) != tt.events {
+				t.Errorf("got %d events, want %d", len(seen), tt.events)
+			}
+		})
+	}
+}
+
+func TestListEventsReportsBrokenRule(t *testing.T) {
+	s, st, cal := newTestServer(t)
+	start := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	event := models.NewEvent(cal.ID, "broken", "", start, start.Add(time.Hour), "FREQ=SOMETIMES", "UTC")
+	if err := st.CreateEvent(event); err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err := s.listEvents(&pb.ListEventsRequest{CalendarId: cal.ID, Start: "2025-01-01T00:00:00Z", End: "2025-02-01T00:00:00Z"})
+	if code := status.Code(err); code != codes.Internal {
+		t.Errorf("code = %v, want %v (err: %v)", code, codes.Internal, err)
+	}
+}
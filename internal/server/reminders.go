@@ -0,0 +1,149 @@
+package server
+
+import (
+	"net/mail"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/recurrence-scheduler/internal/ical"
+	"github.com/recurrence-scheduler/internal/models"
+	pb "github.com/recurrence-scheduler/proto/scheduler/v1"
+)
+
+const (
+	// maxReminders は1つのイベントに設定できるリマインダーの上限
+	maxReminders = 10
+	// maxReminderRecipients はEMAILのリマインダー1件あたりの宛先の上限
+	maxReminderRecipients = 20
+	// maxReminderOffset はインスタンスの開始・終了からずらせる時間の上限
+	maxReminderOffset = 28 * 24 * time.Hour
+	// maxReminderIDLength はクライアントが指定するリマインダーのIDの長さの上限
+	maxReminderIDLength = 128
+)
+
+// remindersFromProto はリマインダーを検証して変換する
+// idを指定しなければ新しいIDを割り当てる。更新で同じIDを指定したリマインダーは発火済みの時刻を引き継ぐ
+func remindersFromProto(pbReminders []*pb.Reminder) ([]models.Reminder, error) {
+	if len(pbReminders) > maxReminders {
+		return nil, status.Errorf(codes.InvalidArgument, "an event can have at most %d reminders", maxReminders)
+	}
+
+	var reminders []models.Reminder
+	seen := make(map[string]bool)
+	for _, pbReminder := range pbReminders {
+		if pbReminder == nil {
+			return nil, status.Error(codes.InvalidArgument, "reminder must not be empty")
+		}
+		reminder, err := reminderFromProto(pbReminder)
+		if err != nil {
+			return nil, err
+		}
+		if seen[reminder.ID] {
+			return nil, status.Error(codes.InvalidArgument, "duplicate reminder id: "+reminder.ID)
+		}
+		seen[reminder.ID] = true
+		reminders = append(reminders, reminder)
+	}
+	return reminders, nil
+}
+
+func reminderFromProto(src *pb.Reminder) (models.Reminder, error) {
+	reminder := models.Reminder{
+		ID:          src.Id,
+		Description: src.Description,
+	}
+	if reminder.ID == "" {
+		reminder.ID = uuid.New().String()
+	} else if len(reminder.ID) > maxReminderIDLength {
+		return reminder, status.Errorf(codes.InvalidArgument, "reminder id must be at most %d characters", maxReminderIDLength)
+	}
+
+	switch src.Action {
+	case pb.ReminderAction_REMINDER_ACTION_UNSPECIFIED, pb.ReminderAction_REMINDER_ACTION_DISPLAY:
+		reminder.Action = models.ReminderDisplay
+	case pb.ReminderAction_REMINDER_ACTION_EMAIL:
+		reminder.Action = models.ReminderEmail
+	default:
+		return reminder, status.Error(codes.InvalidArgument, "invalid reminder action: "+src.Action.String())
+	}
+
+	if src.At != "" {
+		if src.Offset != "" || src.Related != pb.ReminderRelated_REMINDER_RELATED_UNSPECIFIED {
+			return reminder, status.Error(codes.InvalidArgument, "reminder at cannot be combined with offset or related")
+		}
+		at, err := parseTime(src.At)
+		if err != nil {
+			return reminder, status.Error(codes.InvalidArgument, "invalid reminder at")
+		}
+		reminder.At = at
+	} else {
+		if src.Offset != "" {
+			offset, err := ical.ParseDuration(src.Offset)
+			if err != nil {
+				return reminder, status.Error(codes.InvalidArgument, "invalid reminder offset: use an RFC 5545 duration such as -PT15M")
+			}
+			if offset > maxReminderOffset || offset < -maxReminderOffset {
+				return reminder, status.Error(codes.InvalidArgument, "reminder offset must be within 4 weeks")
+			}
+			reminder.Offset = offset
+		}
+		switch src.Related {
+		case pb.ReminderRelated_REMINDER_RELATED_UNSPECIFIED, pb.ReminderRelated_REMINDER_RELATED_START:
+			reminder.Related = models.RelatedStart
+		case pb.ReminderRelated_REMINDER_RELATED_END:
+			reminder.Related = models.RelatedEnd
+		default:
+			return reminder, status.Error(codes.InvalidArgument, "invalid reminder related: "+src.Related.String())
+		}
+	}
+
+	switch {
+	case reminder.Action == models.ReminderDisplay && len(src.Recipients) > 0:
+		return reminder, status.Error(codes.InvalidArgument, "recipients are only allowed for email reminders")
+	case reminder.Action == models.ReminderEmail && len(src.Recipients) == 0:
+		return reminder, status.Error(codes.InvalidArgument, "email reminders require at least one recipient")
+	case len(src.Recipients) > maxReminderRecipients:
+		return reminder, status.Errorf(codes.InvalidArgument, "a reminder can have at most %d recipients", maxReminderRecipients)
+	}
+	for _, recipient := range src.Recipients {
+		addr, err := mail.ParseAddress(recipient)
+		if err != nil {
+			return reminder, status.Error(codes.InvalidArgument, "invalid recipient: "+recipient)
+		}
+		reminder.Recipients = append(reminder.Recipients, addr.Address)
+	}
+
+	return reminder, nil
+}
+
+// remindersToProto はリマインダーをprotoに変換する
+func remindersToProto(reminders []models.Reminder) []*pb.Reminder {
+	var pbReminders []*pb.Reminder
+	for _, reminder := range reminders {
+		pbReminder := &pb.Reminder{
+			Id:          reminder.ID,
+			Action:      pb.ReminderAction_REMINDER_ACTION_DISPLAY,
+			Description: reminder.Description,
+			Recipients:  reminder.Recipients,
+		}
+		if reminder.Action == models.ReminderEmail {
+			pbReminder.Action = pb.ReminderAction_REMINDER_ACTION_EMAIL
+		}
+
+		switch {
+		case reminder.IsAbsolute():
+			pbReminder.At = reminder.At.UTC().Format(time.RFC3339)
+		case reminder.Related == models.RelatedEnd:
+			pbReminder.Offset = ical.FormatDuration(reminder.Offset)
+			pbReminder.Related = pb.ReminderRelated_REMINDER_RELATED_END
+		default:
+			pbReminder.Offset = ical.FormatDuration(reminder.Offset)
+			pbReminder.Related = pb.ReminderRelated_REMINDER_RELATED_START
+		}
+		pbReminders = append(pbReminders, pbReminder)
+	}
+	return pbReminders
+}
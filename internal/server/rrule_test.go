@@ -0,0 +1,61 @@
+package server
+
+import "testing"
+
+func TestRRuleRoundTrip(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string // 空の場合はinと同じ
+	}{
+		{in: "FREQ=DAILY"},
+		{in: "FREQ=WEEKLY;INTERVAL=2;COUNT=10;BYDAY=MO,WE,FR;WKST=SU"},
+		{in: "FREQ=MONTHLY;UNTIL=20251231T235959Z;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1"},
+		{in: "FREQ=MONTHLY;BYDAY=2FR,-1SU;BYMONTH=1,7"},
+		{in: "FREQ=MONTHLY;BYMONTHDAY=-1,15"},
+		{in: "FREQ=YEARLY;BYYEARDAY=1,-1;BYWEEKNO=20,-1"},
+		{in: "FREQ=HOURLY;BYSECOND=0,30;BYMINUTE=0,15;BYHOUR=9,17"},
+		// 順序・大文字小文字・RRULE:の接頭辞は正規化される
+		{in: "RRULE:byday=mo;freq=weekly", want: "FREQ=WEEKLY;BYDAY=MO"},
+		{in: "FREQ=YEARLY;BYSETPOS=1;BYMONTH=3;BYDAY=+1su", want: "FREQ=YEARLY;BYDAY=+1SU;BYMONTH=3;BYSETPOS=1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			want := tt.want
+			if want == "" {
+				want = tt.in
+			}
+			rr, err := rruleToProto(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := protoToRRule(rr); got != want {
+				t.Errorf("round trip = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestRRuleToProtoErrors(t *testing.T) {
+	for _, in := range []string{
+		"INTERVAL=2",
+		"FREQ=FORTNIGHTLY",
+		"FREQ=DAILY;FREQ=WEEKLY",
+		"FREQ=DAILY;COUNT",
+		"FREQ=DAILY;COUNT=0",
+		"FREQ=DAILY;BYHOUR=24",
+		"FREQ=DAILY;BYMINUTE=-1",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=MONTHLY;BYMONTHDAY=0",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=MONTHLY;BYDAY=0MO",
+		"FREQ=WEEKLY;WKST=1MO",
+		"FREQ=DAILY;BYEASTER=0",
+	} {
+		t.Run(in, func(t *testing.T) {
+			if rr, err := rruleToProto(in); err == nil {
+				t.Errorf("rruleToProto(%q) = %v, want an error", in, rr)
+			}
+		})
+	}
+}
@@ -2,16 +2,17 @@ package server
 
 import (
 	"context"
-	"strconv"
-	"strings"
+	"errors"
+	"sort"
 	"time"
 
-	"github.com/teambition/rrule-go"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/recurrence-scheduler/internal/models"
+	"github.com/recurrence-scheduler/internal/recurrence"
 	"github.com/recurrence-scheduler/internal/storage"
+	"github.com/recurrence-scheduler/internal/trigger"
 	pb "github.com/recurrence-scheduler/proto/scheduler/v1"
 )
 
@@ -19,6 +20,8 @@ import (
 type Server struct {
 	pb.UnimplementedSchedulerServiceServer
 	storage storage.Storage
+	// occurrencesはインスタンスの開始の購読元（トリガーを動かしていない場合はnil）
+	occurrences *trigger.Broadcaster
 }
 
 // NewServer は新しいサーバーを作成
@@ -27,159 +30,128 @@ func NewServer(s storage.Storage) *Server {
 }
 
 // protoToRRule はprotoのRecurrenceRuleをRFC 5545形式のRRULE文字列に変換
+// RFC 5545のRECUR規則のすべての要素を出力する
 func protoToRRule(rr *pb.RecurrenceRule) string {
 	if rr == nil {
 		return ""
 	}
 
-	var parts []string
-
-	// FREQ
-	freq := strings.ToUpper(rr.Freq)
-	if freq != "" {
-		parts = append(parts, "FREQ="+freq)
-	}
-
-	// INTERVAL
-	if rr.Interval > 0 {
-		parts = append(parts, "INTERVAL="+strconv.FormatInt(int64(rr.Interval), 10))
-	}
-
-	// COUNT
-	if rr.Count > 0 {
-		parts = append(parts, "COUNT="+strconv.FormatInt(int64(rr.Count), 10))
-	}
-
-	// UNTIL
-	if rr.Until != "" {
-		parts = append(parts, "UNTIL="+rr.Until)
-	}
-
-	// BYDAY
-	if len(rr.Byday) > 0 {
-		parts = append(parts, "BYDAY="+strings.Join(rr.Byday, ","))
-	}
-
-	// BYMONTHDAY
-	if len(rr.Bymonthday) > 0 {
-		parts = append(parts, "BYMONTHDAY="+intSliceToString(rr.Bymonthday))
-	}
-
-	// BYMONTH
-	if len(rr.Bymonth) > 0 {
-		parts = append(parts, "BYMONTH="+intSliceToString(rr.Bymonth))
-	}
+	rule := recurrence.Rule{
+		Freq:       rr.Freq,
+		Interval:   rr.Interval,
+		Count:      rr.Count,
+		Until:      rr.Until,
+		BySecond:   rr.Bysecond,
+		ByMinute:   rr.Byminute,
+		ByHour:     rr.Byhour,
+		ByDay:      rr.Byday,
+		ByMonthDay: rr.Bymonthday,
+		ByYearDay:  rr.Byyearday,
+		ByWeekNo:   rr.Byweekno,
+		ByMonth:    rr.Bymonth,
+		BySetPos:   rr.Bysetpos,
+		Wkst:       rr.Wkst,
+	}
+	return rule.String()
+}
 
-	// BYWEEKNO
-	if len(rr.Byweekno) > 0 {
-		parts = append(parts, "BYWEEKNO="+intSliceToString(rr.Byweekno))
+// parseTime はRFC3339形式の文字列をtime.Timeに変換
+func parseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, status.Error(codes.InvalidArgument, "time string is empty")
 	}
+	return time.Parse(time.RFC3339, s)
+}
 
-	// WKST
-	if rr.Wkst != "" {
-		parts = append(parts, "WKST="+rr.Wkst)
+// parseTimes はRFC3339形式の文字列のリストをtime.Timeのリストに変換
+func parseTimes(ss []string) ([]time.Time, error) {
+	var ts []time.Time
+	for _, str := range ss {
+		t, err := parseTime(str)
+		if err != nil {
+			return nil, err
+		}
+		ts = append(ts, t)
 	}
-
-	return strings.Join(parts, ";")
+	return ts, nil
 }
 
-func intSliceToString(is []int32) string {
-	if len(is) == 0 {
-		return ""
-	}
-	
-	var parts []string
-	for _, i := range is {
-		parts = append(parts, strconv.FormatInt(int64(i), 10))
+// formatTimes はtime.Timeのリストを指定したLocationのRFC3339形式の文字列に変換
+func formatTimes(ts []time.Time, loc *time.Location) []string {
+	var ss []string
+	for _, t := range ts {
+		ss = append(ss, t.In(loc).Format(time.RFC3339))
 	}
-	return strings.Join(parts, ",")
+	return ss
 }
 
-// parseTime はRFC3339形式の文字列をtime.Timeに変換
-func parseTime(s string) (time.Time, error) {
-	if s == "" {
-		return time.Time{}, status.Error(codes.InvalidArgument, "time string is empty")
+// validateTimezone はタイムゾーンがIANAタイムゾーンとして読み込めるか検証
+func validateTimezone(tz string) error {
+	if _, err := time.LoadLocation(tz); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid timezone: %s", tz)
 	}
-	return time.Parse(time.RFC3339, s)
+	return nil
 }
 
 // rruleToProto はRFC 5545形式のRRULE文字列をprotoのRecurrenceRuleに変換
-// 簡略化版: RRULE文字列をパースして基本的な情報のみ取得
-func rruleToProto(rruleStr string) *pb.RecurrenceRule {
+// RECUR規則のすべての要素を保持するため、protoToRRuleとの往復で情報は失われない
+func rruleToProto(rruleStr string) (*pb.RecurrenceRule, error) {
 	if rruleStr == "" {
-		return nil
+		return nil, nil
 	}
 
-	// RRULE文字列をパースして検証のみ行う
-	_, err := rrule.StrToRRule(rruleStr)
+	rule, err := recurrence.ParseRule(rruleStr)
 	if err != nil {
-		return nil
-	}
-
-	// RRULE文字列から基本的な情報を抽出（簡略化）
-	// 完全なパースはクライアント側で行う前提
-	rr := &pb.RecurrenceRule{}
-	
-	// FREQの抽出（簡単な例）
-	parts := strings.Split(rruleStr, ";")
-	for _, part := range parts {
-		if strings.HasPrefix(part, "FREQ=") {
-			rr.Freq = strings.ToUpper(strings.TrimPrefix(part, "FREQ="))
-		} else if strings.HasPrefix(part, "INTERVAL=") {
-			if val, err := strconv.ParseInt(strings.TrimPrefix(part, "INTERVAL="), 10, 32); err == nil {
-				rr.Interval = int32(val)
-			}
-		} else if strings.HasPrefix(part, "COUNT=") {
-			if val, err := strconv.ParseInt(strings.TrimPrefix(part, "COUNT="), 10, 32); err == nil {
-				rr.Count = int32(val)
-			}
-		} else if strings.HasPrefix(part, "UNTIL=") {
-			rr.Until = strings.TrimPrefix(part, "UNTIL=")
-		} else if strings.HasPrefix(part, "BYDAY=") {
-			days := strings.Split(strings.TrimPrefix(part, "BYDAY="), ",")
-			rr.Byday = days
-		} else if strings.HasPrefix(part, "BYMONTHDAY=") {
-			daysStr := strings.Split(strings.TrimPrefix(part, "BYMONTHDAY="), ",")
-			for _, d := range daysStr {
-				if val, err := strconv.ParseInt(d, 10, 32); err == nil {
-					rr.Bymonthday = append(rr.Bymonthday, int32(val))
-				}
-			}
-		} else if strings.HasPrefix(part, "BYMONTH=") {
-			monthsStr := strings.Split(strings.TrimPrefix(part, "BYMONTH="), ",")
-			for _, m := range monthsStr {
-				if val, err := strconv.ParseInt(m, 10, 32); err == nil {
-					rr.Bymonth = append(rr.Bymonth, int32(val))
-				}
-			}
-		} else if strings.HasPrefix(part, "BYWEEKNO=") {
-			weeksStr := strings.Split(strings.TrimPrefix(part, "BYWEEKNO="), ",")
-			for _, w := range weeksStr {
-				if val, err := strconv.ParseInt(w, 10, 32); err == nil {
-					rr.Byweekno = append(rr.Byweekno, int32(val))
-				}
-			}
-		} else if strings.HasPrefix(part, "WKST=") {
-			rr.Wkst = strings.ToUpper(strings.TrimPrefix(part, "WKST="))
-		}
-	}
-
-	return rr
+		return nil, err
+	}
+
+	return &pb.RecurrenceRule{
+		Freq:       rule.Freq,
+		Interval:   rule.Interval,
+		Count:      rule.Count,
+		Until:      rule.Until,
+		Bysecond:   rule.BySecond,
+		Byminute:   rule.ByMinute,
+		Byhour:     rule.ByHour,
+		Byday:      rule.ByDay,
+		Bymonthday: rule.ByMonthDay,
+		Byyearday:  rule.ByYearDay,
+		Byweekno:   rule.ByWeekNo,
+		Bymonth:    rule.ByMonth,
+		Bysetpos:   rule.BySetPos,
+		Wkst:       rule.Wkst,
+	}, nil
 }
 
 // eventToProto はEventモデルをprotoのEventに変換
-func eventToProto(e *models.Event) *pb.Event {
-	return &pb.Event{
+// 開始・終了時刻はイベントのタイムゾーンのオフセットで表現し、繰り返しルールの説明はlangで書く
+func eventToProto(e *models.Event, lang language) *pb.Event {
+	loc := recurrence.Location(e.Timezone)
+	// RRULEは保存前にvalidateRRuleで検証しているため、通常は解析に失敗しない
+	rrule, _ := rruleToProto(e.RRule)
+	pbEvent := &pb.Event{
 		Id:          e.ID,
 		Title:       e.Title,
 		Description: e.Description,
-		Dtstart:     e.DTStart.Format(time.RFC3339),
-		Dtend:       e.DTEnd.Format(time.RFC3339),
-		Rrule:       rruleToProto(e.RRule),
+		Dtstart:     e.DTStart.In(loc).Format(time.RFC3339),
+		Dtend:       e.DTEnd.In(loc).Format(time.RFC3339),
+		Rrule:       rrule,
+		Exdates:     formatTimes(e.ExDates, loc),
+		Rdates:      formatTimes(e.RDates, loc),
 		Timezone:    e.Timezone,
 		CreatedAt:   e.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:   e.UpdatedAt.Format(time.RFC3339),
 	}
+	pbEvent.RelatedTo = e.RelatedTo
+	pbEvent.Uid = e.UID
+	pbEvent.Reminders = remindersToProto(e.Reminders)
+	pbEvent.RruleText = describeRule(rrule, e.DTStart.In(loc), loc, lang)
+	pbEvent.AllDay = e.AllDay
+	if e.RecurringEventID != "" {
+		pbEvent.RecurringEventId = e.RecurringEventID
+		pbEvent.RecurrenceId = e.RecurrenceID.In(loc).Format(time.RFC3339)
+	}
+	return pbEvent
 }
 
 // calendarToProto はCalendarモデルをprotoのCalendarに変換
@@ -200,6 +172,9 @@ func (s *Server) CreateCalendar(ctx context.Context, req *pb.CreateCalendarReque
 	if timezone == "" {
 		timezone = "UTC"
 	}
+	if err := validateTimezone(timezone); err != nil {
+		return nil, err
+	}
 
 	cal := models.NewCalendar(req.Name, req.Description, timezone)
 	if err := s.storage.CreateCalendar(cal); err != nil {
@@ -220,23 +195,96 @@ func (s *Server) GetCalendar(ctx context.Context, req *pb.GetCalendarRequest) (*
 }
 
 // ListCalendars はカレンダー一覧を取得
+// page_tokenが指す位置から続きを返し、続きがあればnext_page_tokenを設定する
 func (s *Server) ListCalendars(ctx context.Context, req *pb.ListCalendarsRequest) (*pb.ListCalendarsResponse, error) {
-	pageSize := int(req.PageSize)
-	if pageSize <= 0 || pageSize > 100 {
-		pageSize = 50
+	pageSize := normalizePageSize(req.PageSize)
+	cursor, err := decodePageToken(req.PageToken, "")
+	if err != nil {
+		return nil, err
 	}
 
-	calendars, err := s.storage.ListCalendars(pageSize, 0)
+	// 続きがあるかを判定するため1件多く取得する
+	calendars, err := s.storage.ListCalendars(cursor, pageSize+1)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	total, err := s.storage.CountCalendars()
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	var nextPageToken string
+	if len(calendars) > pageSize {
+		calendars = calendars[:pageSize]
+		nextPageToken = encodePageToken(storage.CalendarCursor(calendars[pageSize-1]), "")
+	}
+
 	var pbCalendars []*pb.Calendar
 	for _, cal := range calendars {
 		pbCalendars = append(pbCalendars, calendarToProto(cal))
 	}
 
-	return &pb.ListCalendarsResponse{Calendars: pbCalendars}, nil
+	return &pb.ListCalendarsResponse{Calendars: pbCalendars, NextPageToken: nextPageToken, TotalSize: int32(total)}, nil
+}
+
+// UpdateCalendar はカレンダーを更新する
+// update_maskが空の場合は変更可能なすべてのフィールドを置き換える
+func (s *Server) UpdateCalendar(ctx context.Context, req *pb.UpdateCalendarRequest) (*pb.UpdateCalendarResponse, error) {
+	if req.Calendar == nil || req.Calendar.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "calendar.id is required")
+	}
+
+	cal, err := s.storage.GetCalendar(req.Calendar.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "calendar not found")
+	}
+
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		paths = []string{"name", "description", "timezone"}
+	}
+
+	for _, path := range paths {
+		switch path {
+		case "name":
+			cal.Name = req.Calendar.Name
+		case "description":
+			cal.Description = req.Calendar.Description
+		case "timezone":
+			cal.Timezone = req.Calendar.Timezone
+			if cal.Timezone == "" {
+				cal.Timezone = "UTC"
+			}
+			if err := validateTimezone(cal.Timezone); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update_mask path: %s", path)
+		}
+	}
+
+	if err := s.storage.UpdateCalendar(cal); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.UpdateCalendarResponse{Calendar: calendarToProto(cal)}, nil
+}
+
+// DeleteCalendar はカレンダーを削除
+// cascadeがtrueの場合は所属するイベントもまとめて削除する
+func (s *Server) DeleteCalendar(ctx context.Context, req *pb.DeleteCalendarRequest) (*pb.DeleteCalendarResponse, error) {
+	if _, err := s.storage.GetCalendar(req.CalendarId); err != nil {
+		return nil, status.Error(codes.NotFound, "calendar not found")
+	}
+
+	if err := s.storage.DeleteCalendar(req.CalendarId, req.Cascade); err != nil {
+		if errors.Is(err, storage.ErrCalendarNotEmpty) {
+			return nil, status.Error(codes.FailedPrecondition, "calendar has events; set cascade to delete them")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.DeleteCalendarResponse{}, nil
 }
 
 // CreateEvent はイベントを作成
@@ -259,15 +307,58 @@ func (s *Server) CreateEvent(ctx context.Context, req *pb.CreateEventRequest) (*
 	if timezone == "" {
 		timezone = "UTC"
 	}
+	if err := validateTimezone(timezone); err != nil {
+		return nil, err
+	}
+
+	exDates, err := parseTimes(req.Exdates)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid exdates")
+	}
+
+	rDates, err := parseTimes(req.Rdates)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid rdates")
+	}
 
 	rruleStr := protoToRRule(req.Rrule)
+	if req.Cron != "" && rruleStr != "" {
+		return nil, invalidCron("cron and rrule must not both be specified")
+	}
+	if err := validateRRule(rruleStr, dtStart); err != nil {
+		return nil, err
+	}
+
+	reminders, err := remindersFromProto(req.Reminders)
+	if err != nil {
+		return nil, err
+	}
 
 	event := models.NewEvent(req.CalendarId, req.Title, req.Description, dtStart, dtEnd, rruleStr, timezone)
+	event.ExDates = exDates
+	event.RDates = rDates
+	event.Reminders = reminders
+
+	if req.Cron != "" {
+		if err := applyCron(req.Cron, event); err != nil {
+			return nil, err
+		}
+	}
+
+	conflicts, err := s.checkConflicts(event, conflictCheck{
+		policy:      req.ConflictPolicy,
+		calendarIDs: req.ConflictCalendarIds,
+		horizonDays: req.ConflictHorizonDays,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	if err := s.storage.CreateEvent(event); err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &pb.CreateEventResponse{Event: eventToProto(event)}, nil
+	return &pb.CreateEventResponse{Event: eventToProto(event, languageFromContext(ctx)), Conflicts: conflicts}, nil
 }
 
 // GetEvent はイベントを取得
@@ -277,49 +368,557 @@ func (s *Server) GetEvent(ctx context.Context, req *pb.GetEventRequest) (*pb.Get
 		return nil, status.Error(codes.NotFound, "event not found")
 	}
 
-	return &pb.GetEventResponse{Event: eventToProto(event)}, nil
+	return &pb.GetEventResponse{Event: eventToProto(event, languageFromContext(ctx))}, nil
 }
 
 // ListEvents はイベント一覧を取得
+// total_sizeは期間内にインスタンスを持つかを判定する前の候補数で、実際の件数以上の概算になる
 func (s *Server) ListEvents(ctx context.Context, req *pb.ListEventsRequest) (*pb.ListEventsResponse, error) {
+	events, nextPageToken, err := s.listEvents(req)
+	if err != nil {
+		return nil, err
+	}
+
+	start, _ := parseTime(req.Start)
+	end, _ := parseTime(req.End)
+	total, err := s.storage.CountEvents(req.CalendarId, start, end)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	lang := languageFromContext(ctx)
+	var pbEvents []*pb.Event
+	for _, event := range events {
+		pbEvents = append(pbEvents, eventToProto(event, lang))
+	}
+
+	return &pb.ListEventsResponse{Events: pbEvents, NextPageToken: nextPageToken, TotalSize: int32(total)}, nil
+}
+
+// listEvents は期間内にインスタンスを持つイベントを開始日時順に1ページ分返す
+// 期間内にインスタンスを持たない候補は読み飛ばし、ページが埋まるまで続きを取得する
+func (s *Server) listEvents(req *pb.ListEventsRequest) ([]*models.Event, string, error) {
 	start, err := parseTime(req.Start)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid start time")
+		return nil, "", status.Error(codes.InvalidArgument, "invalid start time")
 	}
 
 	end, err := parseTime(req.End)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid end time")
+		return nil, "", status.Error(codes.InvalidArgument, "invalid end time")
 	}
 
-	pageSize := int(req.PageSize)
-	if pageSize <= 0 || pageSize > 100 {
-		pageSize = 50
+	pageSize := normalizePageSize(req.PageSize)
+	filter := req.CalendarId + "|" + req.Start + "|" + req.End
+	cursor, err := decodePageToken(req.PageToken, filter)
+	if err != nil {
+		return nil, "", err
 	}
 
-	events, err := s.storage.ListEvents(req.CalendarId, start, end, pageSize, 0)
+	overrides, err := s.storage.ListCalendarOverrides(req.CalendarId)
+	if err != nil {
+		return nil, "", status.Error(codes.Internal, err.Error())
+	}
+	overridesByEvent := make(map[string][]*models.Event)
+	for _, override := range overrides {
+		overridesByEvent[override.RecurringEventID] = append(overridesByEvent[override.RecurringEventID], override)
+	}
+
+	// 次のページがあるかを判定するため、pageSize+1件目まで探す
+	var matched []*models.Event
+	for {
+		events, err := s.storage.ListEvents(req.CalendarId, start, end, cursor, pageSize+1)
+		if err != nil {
+			return nil, "", status.Error(codes.Internal, err.Error())
+		}
+
+		for _, event := range events {
+			cursor = storage.EventCursor(event)
+			// 期間より前に始まった繰り返しイベントは、期間内にインスタンスがある場合のみ返す
+			instances, err := recurrence.Expand(event, overridesByEvent[event.ID], start, end)
+			if err != nil {
+				return nil, "", status.Errorf(codes.Internal, "expand event %s: %v", event.ID, err)
+			}
+			if len(instances) == 0 {
+				continue
+			}
+			matched = append(matched, event)
+			if len(matched) > pageSize {
+				matched = matched[:pageSize]
+				return matched, encodePageToken(storage.EventCursor(matched[pageSize-1]), filter), nil
+			}
+		}
+
+		if len(events) <= pageSize {
+			return matched, "", nil
+		}
+	}
+}
+
+// ListOccurrences はカレンダー内の全イベントを展開し、期間内の具体的なインスタンスを開始時刻順に返す
+func (s *Server) ListOccurrences(ctx context.Context, req *pb.ListOccurrencesRequest) (*pb.ListOccurrencesResponse, error) {
+	instances, nextPageToken, err := s.listOccurrences(req)
+	if err != nil {
+		return nil, err
+	}
+
+	lang := languageFromContext(ctx)
+	var pbInstances []*pb.Event
+	for _, instance := range instances {
+		pbInstances = append(pbInstances, eventToProto(instance, lang))
+	}
+
+	return &pb.ListOccurrencesResponse{Instances: pbInstances, NextPageToken: nextPageToken}, nil
+}
+
+// listOccurrences はカレンダー内の期間内のインスタンスを（開始時刻, ID）順に1ページ分返す
+// 続きがある場合は最後のインスタンスの開始時刻とIDを次のページのpage_tokenにする
+func (s *Server) listOccurrences(req *pb.ListOccurrencesRequest) ([]*models.Event, string, error) {
+	start, err := parseTime(req.Start)
+	if err != nil {
+		return nil, "", status.Error(codes.InvalidArgument, "invalid start time")
+	}
+
+	end, err := parseTime(req.End)
+	if err != nil {
+		return nil, "", status.Error(codes.InvalidArgument, "invalid end time")
+	}
+
+	pageSize := normalizePageSize(req.PageSize)
+	filter := req.CalendarId + "|" + req.Start + "|" + req.End
+	cursor, err := decodePageToken(req.PageToken, filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	instances, err := s.expandCalendar(req.CalendarId, start, end)
+	if err != nil {
+		return nil, "", err
+	}
+	// 同じ開始時刻のインスタンスはIDで並べ、ページの境界を一意にする
+	sort.SliceStable(instances, func(i, j int) bool {
+		if !instances[i].DTStart.Equal(instances[j].DTStart) {
+			return instances[i].DTStart.Before(instances[j].DTStart)
+		}
+		return instances[i].ID < instances[j].ID
+	})
+
+	if !cursor.IsZero() {
+		after, err := time.Parse(time.RFC3339Nano, cursor.Key)
+		if err != nil {
+			return nil, "", status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		i := sort.Search(len(instances), func(i int) bool {
+			instance := instances[i]
+			return instance.DTStart.After(after) || (instance.DTStart.Equal(after) && instance.ID > cursor.ID)
+		})
+		instances = instances[i:]
+	}
+
+	if len(instances) <= pageSize {
+		return instances, "", nil
+	}
+	instances = instances[:pageSize]
+	last := instances[pageSize-1]
+	next := storage.Cursor{Key: last.DTStart.UTC().Format(time.RFC3339Nano), ID: last.ID}
+	return instances, encodePageToken(next, filter), nil
+}
+
+// expandCalendar はカレンダー内の全イベントをオーバーライドとEXDATEを反映して展開し、
+// 期間と重なるインスタンスを開始時刻順に返す
+func (s *Server) expandCalendar(calendarID string, start, end time.Time) ([]*models.Event, error) {
+	events, err := s.storage.ListEvents(calendarID, start, end, storage.Cursor{}, -1)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	var pbEvents []*pb.Event
-	for _, event := range events {
-		pbEvents = append(pbEvents, eventToProto(event))
+	overrides, err := s.storage.ListCalendarOverrides(calendarID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &pb.ListEventsResponse{Events: pbEvents}, nil
+	instances, err := recurrence.ExpandAll(events, overrides, start, end)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return instances, nil
+}
+
+// eventUpdatePaths はUpdateEventのupdate_maskで指定できるフィールド
+var eventUpdatePaths = []string{"title", "description", "dtstart", "dtend", "rrule", "exdates", "rdates", "timezone", "reminders"}
+
+// applyEventUpdate はupdate_maskのパスに従ってsrcの値をeventに反映する
+// pathsが空の場合はallowedのすべてを置き換える
+func applyEventUpdate(event *models.Event, src *pb.Event, paths, allowed []string) error {
+	if len(paths) == 0 {
+		paths = allowed
+	}
+
+	for _, path := range paths {
+		if !containsString(allowed, path) {
+			return status.Errorf(codes.InvalidArgument, "unsupported update_mask path: %s", path)
+		}
+
+		switch path {
+		case "title":
+			event.Title = src.Title
+		case "description":
+			event.Description = src.Description
+		case "dtstart":
+			dtStart, err := parseTime(src.Dtstart)
+			if err != nil {
+				return status.Error(codes.InvalidArgument, "invalid dtstart")
+			}
+			event.DTStart = dtStart
+		case "dtend":
+			dtEnd, err := parseTime(src.Dtend)
+			if err != nil {
+				return status.Error(codes.InvalidArgument, "invalid dtend")
+			}
+			event.DTEnd = dtEnd
+		case "rrule":
+			event.RRule = protoToRRule(src.Rrule)
+		case "exdates":
+			exDates, err := parseTimes(src.Exdates)
+			if err != nil {
+				return status.Error(codes.InvalidArgument, "invalid exdates")
+			}
+			event.ExDates = exDates
+		case "rdates":
+			rDates, err := parseTimes(src.Rdates)
+			if err != nil {
+				return status.Error(codes.InvalidArgument, "invalid rdates")
+			}
+			event.RDates = rDates
+		case "timezone":
+			event.Timezone = src.Timezone
+			if event.Timezone == "" {
+				event.Timezone = "UTC"
+			}
+			if err := validateTimezone(event.Timezone); err != nil {
+				return err
+			}
+		case "reminders":
+			reminders, err := remindersFromProto(src.Reminders)
+			if err != nil {
+				return err
+			}
+			event.Reminders = reminders
+		}
+	}
+
+	if event.DTEnd.Before(event.DTStart) {
+		return status.Error(codes.InvalidArgument, "dtend must be after dtstart")
+	}
+
+	if containsString(paths, "rrule") || containsString(paths, "dtstart") {
+		if err := validateRRule(event.RRule, event.DTStart); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func containsString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// UpdateEvent はイベントを更新する
+// update_maskが空の場合は変更可能なすべてのフィールドを置き換える
+func (s *Server) UpdateEvent(ctx context.Context, req *pb.UpdateEventRequest) (*pb.UpdateEventResponse, error) {
+	if req.Event == nil || req.Event.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "event.id is required")
+	}
+
+	event, err := s.storage.GetEvent(req.Event.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+
+	if err := applyEventUpdate(event, req.Event, req.GetUpdateMask().GetPaths(), eventUpdatePaths); err != nil {
+		return nil, err
+	}
+
+	conflicts, err := s.checkConflicts(event, conflictCheck{
+		policy:      req.ConflictPolicy,
+		calendarIDs: req.ConflictCalendarIds,
+		horizonDays: req.ConflictHorizonDays,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.storage.UpdateEvent(event); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.UpdateEventResponse{Event: eventToProto(event, languageFromContext(ctx)), Conflicts: conflicts}, nil
+}
+
+// DeleteEvent はイベントを削除
+func (s *Server) DeleteEvent(ctx context.Context, req *pb.DeleteEventRequest) (*pb.DeleteEventResponse, error) {
+	if _, err := s.storage.GetEvent(req.EventId); err != nil {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+
+	if err := s.storage.DeleteEvent(req.EventId); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.DeleteEventResponse{}, nil
+}
+
+// AddExceptionDate は繰り返しイベントの1インスタンスをEXDATEで取り消す
+func (s *Server) AddExceptionDate(ctx context.Context, req *pb.AddExceptionDateRequest) (*pb.AddExceptionDateResponse, error) {
+	event, err := s.storage.GetEvent(req.EventId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+
+	occurrence, err := parseTime(req.OccurrenceStart)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid occurrence_start")
+	}
+
+	// すでに取り消されているインスタンスはEXDATEを重複させず、そのまま返す
+	for _, exDate := range event.ExDates {
+		if exDate.Equal(occurrence) {
+			return &pb.AddExceptionDateResponse{Event: eventToProto(event, languageFromContext(ctx))}, nil
+		}
+	}
+
+	ok, err := recurrence.IsOccurrence(event, occurrence)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "occurrence_start is not an occurrence of the event")
+	}
+
+	event.ExDates = append(event.ExDates, occurrence)
+	if err := s.storage.UpdateEvent(event); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.AddExceptionDateResponse{Event: eventToProto(event, languageFromContext(ctx))}, nil
+}
+
+// RemoveExceptionDate はEXDATEを取り除き、取り消したインスタンスを元に戻す
+func (s *Server) RemoveExceptionDate(ctx context.Context, req *pb.RemoveExceptionDateRequest) (*pb.RemoveExceptionDateResponse, error) {
+	event, err := s.storage.GetEvent(req.EventId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+
+	occurrence, err := parseTime(req.OccurrenceStart)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid occurrence_start")
+	}
+
+	var exDates []time.Time
+	for _, exDate := range event.ExDates {
+		if !exDate.Equal(occurrence) {
+			exDates = append(exDates, exDate)
+		}
+	}
+	if len(exDates) == len(event.ExDates) {
+		return nil, status.Error(codes.NotFound, "exception date not found")
+	}
+
+	event.ExDates = exDates
+	if err := s.storage.UpdateEvent(event); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.RemoveExceptionDateResponse{Event: eventToProto(event, languageFromContext(ctx))}, nil
+}
+
+// OverrideOccurrence は繰り返しイベントの1インスタンスだけを変更する
+// オーバーライドはRECURRENCE-IDで元のインスタンスと紐付けられ、IDはインスタンスIDと同じになる
+func (s *Server) OverrideOccurrence(ctx context.Context, req *pb.OverrideOccurrenceRequest) (*pb.OverrideOccurrenceResponse, error) {
+	if req.Event == nil {
+		return nil, status.Error(codes.InvalidArgument, "event is required")
+	}
+
+	event, err := s.storage.GetEvent(req.EventId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+	if !recurrence.IsRecurring(event) {
+		return nil, status.Error(codes.FailedPrecondition, "event is not recurring")
+	}
+
+	occurrence, err := parseTime(req.OccurrenceStart)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid occurrence_start")
+	}
+
+	override, err := s.storage.GetEvent(recurrence.InstanceID(event.ID, occurrence))
+	exists := err == nil
+	if !exists {
+		ok, err := recurrence.IsOccurrence(event, occurrence)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "occurrence_start is not an occurrence of the event")
+		}
+		override = recurrence.NewOverride(event, occurrence)
+	}
+
+	overridePaths := []string{"title", "description", "dtstart", "dtend", "reminders"}
+	if err := applyEventUpdate(override, req.Event, req.GetUpdateMask().GetPaths(), overridePaths); err != nil {
+		return nil, err
+	}
+
+	if exists {
+		err = s.storage.UpdateEvent(override)
+	} else {
+		err = s.storage.CreateEvent(override)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.OverrideOccurrenceResponse{Event: eventToProto(override, languageFromContext(ctx))}, nil
+}
+
+// SplitSeries は繰り返しイベントを指定したインスタンスで分割する（「これ以降のすべて」の変更）
+// 元のイベントはRRULEにUNTILを設定して分割点の直前で打ち切り、分割点からの新しいイベントに変更内容を適用する
+// 両者はRELATED-TOで紐付け、すべて1トランザクションで保存する
+func (s *Server) SplitSeries(ctx context.Context, req *pb.SplitSeriesRequest) (*pb.SplitSeriesResponse, error) {
+	original, err := s.storage.GetEvent(req.EventId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+	if original.RRule == "" {
+		return nil, status.Error(codes.FailedPrecondition, "event has no recurrence rule")
+	}
+
+	from, err := parseTime(req.FromOccurrence)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid from_occurrence")
+	}
+	if !from.After(original.DTStart) {
+		return nil, status.Error(codes.InvalidArgument, "from_occurrence must be after the first occurrence; use UpdateEvent to change the whole series")
+	}
+
+	ok, err := recurrence.IsOccurrence(original, from)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "from_occurrence is not an occurrence of the event")
+	}
+
+	beforeRRule, afterRRule, err := recurrence.SplitRRule(original, from)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	next := models.NewEvent(original.CalendarID, original.Title, original.Description,
+		from, from.Add(original.DTEnd.Sub(original.DTStart)), afterRRule, original.Timezone)
+	next.RelatedTo = original.ID
+	next.AllDay = original.AllDay
+	// 絶対時刻のリマインダーは元のイベントにだけ残す
+	for _, reminder := range original.Reminders {
+		if !reminder.IsAbsolute() {
+			next.Reminders = append(next.Reminders, reminder)
+		}
+	}
+
+	if req.Event != nil {
+		splitPaths := []string{"title", "description", "dtstart", "dtend", "rrule", "timezone", "reminders"}
+		if err := applyEventUpdate(next, req.Event, req.GetUpdateMask().GetPaths(), splitPaths); err != nil {
+			return nil, err
+		}
+		if next.RRule == "" {
+			return nil, status.Error(codes.InvalidArgument, "rrule is required for the new series")
+		}
+	}
+
+	// 分割点以降のEXDATE・RDATE・オーバーライドは、開始時刻の変更分だけずらして新しいイベントに移す
+	shift := next.DTStart.Sub(from)
+
+	var exDates []time.Time
+	for _, exDate := range original.ExDates {
+		if exDate.Before(from) {
+			exDates = append(exDates, exDate)
+		} else {
+			next.ExDates = append(next.ExDates, exDate.Add(shift))
+		}
+	}
+
+	var rDates []time.Time
+	for _, rDate := range original.RDates {
+		if rDate.Before(from) {
+			rDates = append(rDates, rDate)
+		} else {
+			next.RDates = append(next.RDates, rDate.Add(shift))
+		}
+	}
+
+	overrides, err := s.storage.ListOverrides(original.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	moved := make(map[string]*models.Event)
+	for _, override := range overrides {
+		if override.RecurrenceID.Before(from) {
+			continue
+		}
+		oldID := override.ID
+		override.RecurringEventID = next.ID
+		override.RecurrenceID = override.RecurrenceID.Add(shift)
+		override.ID = recurrence.InstanceID(next.ID, override.RecurrenceID)
+		moved[oldID] = override
+	}
+
+	original.RRule = beforeRRule
+	original.ExDates = exDates
+	original.RDates = rDates
+
+	if err := s.storage.SplitEvent(original, next, moved); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	lang := languageFromContext(ctx)
+	return &pb.SplitSeriesResponse{Original: eventToProto(original, lang), Next: eventToProto(next, lang)}, nil
 }
 
 // ExpandRecurrence は繰り返しイベントを展開
 func (s *Server) ExpandRecurrence(ctx context.Context, req *pb.ExpandRecurrenceRequest) (*pb.ExpandRecurrenceResponse, error) {
+	instances, err := s.expandRecurrence(req)
+	if err != nil {
+		return nil, err
+	}
+
+	lang := languageFromContext(ctx)
+	var pbInstances []*pb.Event
+	for _, instance := range instances {
+		pbInstances = append(pbInstances, eventToProto(instance, lang))
+	}
+
+	return &pb.ExpandRecurrenceResponse{Instances: pbInstances}, nil
+}
+
+// expandRecurrence はイベントを期間内のインスタンスに展開する
+func (s *Server) expandRecurrence(req *pb.ExpandRecurrenceRequest) ([]*models.Event, error) {
 	event, err := s.storage.GetEvent(req.EventId)
 	if err != nil {
 		return nil, status.Error(codes.NotFound, "event not found")
 	}
 
-	if event.RRule == "" {
+	if !recurrence.IsRecurring(event) {
 		// 繰り返しがない場合は単一のイベントを返す
-		return &pb.ExpandRecurrenceResponse{Instances: []*pb.Event{eventToProto(event)}}, nil
+		return []*models.Event{event}, nil
 	}
 
 	start, err := parseTime(req.Start)
@@ -332,37 +931,15 @@ func (s *Server) ExpandRecurrence(ctx context.Context, req *pb.ExpandRecurrenceR
 		return nil, status.Error(codes.InvalidArgument, "invalid end time")
 	}
 
-	// RRULEをパース
-	rule, err := rrule.StrToRRule(event.RRule)
+	overrides, err := s.storage.ListOverrides(event.ID)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid rrule: "+err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	rule.DTStart(event.DTStart)
-
-	// 繰り返しインスタンスを生成
-	instances := rule.Between(start, end, true)
-	var pbInstances []*pb.Event
-
-	for _, instance := range instances {
-		duration := event.DTEnd.Sub(event.DTStart)
-		instanceEnd := instance.Add(duration)
-
-		instanceEvent := &models.Event{
-			ID:          event.ID + "-" + instance.Format("20060102T150405Z"),
-			CalendarID:  event.CalendarID,
-			Title:       event.Title,
-			Description: event.Description,
-			DTStart:     instance,
-			DTEnd:       instanceEnd,
-			RRule:       "", // インスタンスにはRRULEを持たない
-			Timezone:    event.Timezone,
-			CreatedAt:   event.CreatedAt,
-			UpdatedAt:   event.UpdatedAt,
-		}
-
-		pbInstances = append(pbInstances, eventToProto(instanceEvent))
+	instances, err := recurrence.Expand(event, overrides, start, end)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid rrule: "+err.Error())
 	}
 
-	return &pb.ExpandRecurrenceResponse{Instances: pbInstances}, nil
+	return instances, nil
 }
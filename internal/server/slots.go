@@ -0,0 +1,140 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/recurrence-scheduler/internal/freebusy"
+	"github.com/recurrence-scheduler/internal/recurrence"
+	pb "github.com/recurrence-scheduler/proto/scheduler/v1"
+)
+
+const (
+	// slotStep は提案する枠の開始時刻を揃える単位
+	slotStep = 15 * time.Minute
+	// defaultMaxSlots と maxSlots は提案する枠の件数の既定値と上限
+	defaultMaxSlots = 10
+	maxSlots        = 100
+	// maxBufferMinutes は前後の余裕として指定できる分数の上限
+	maxBufferMinutes = 24 * 60
+)
+
+// weekdays はiCalendarの曜日の表記（BYDAYと同じ）
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+// defaultWorkingDays はworking_hours.daysが空の場合の曜日（月曜から金曜）
+var defaultWorkingDays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+// FindAvailableSlots はすべてのカレンダーが空いている時間から、duration_minutesの枠を早い順に提案する
+// working_hoursは各カレンダーのタイムゾーンで解釈し、すべてのカレンダーの勤務時間内に収まる枠だけを返す
+// buffer_minutesを指定すると、既存の予定との間にその分の余裕を空ける
+func (s *Server) FindAvailableSlots(ctx context.Context, req *pb.FindAvailableSlotsRequest) (*pb.FindAvailableSlotsResponse, error) {
+	start, end, err := parseWindow(req.Start, req.End, maxFreeBusyWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	duration := time.Duration(req.DurationMinutes) * time.Minute
+	if duration <= 0 || duration > end.Sub(start) {
+		return nil, status.Error(codes.InvalidArgument, "duration_minutes must be positive and fit in the window")
+	}
+	if req.BufferMinutes < 0 || req.BufferMinutes > maxBufferMinutes {
+		return nil, status.Errorf(codes.InvalidArgument, "buffer_minutes must be between 0 and %d", maxBufferMinutes)
+	}
+	buffer := time.Duration(req.BufferMinutes) * time.Minute
+
+	maxResults := int(req.MaxResults)
+	switch {
+	case maxResults <= 0:
+		maxResults = defaultMaxSlots
+	case maxResults > maxSlots:
+		maxResults = maxSlots
+	}
+
+	workingHours, err := parseWorkingHours(req.WorkingHours)
+	if err != nil {
+		return nil, err
+	}
+	if req.Timezone != "" {
+		if err := validateTimezone(req.Timezone); err != nil {
+			return nil, err
+		}
+	}
+
+	// 期間の直前・直後の予定も余裕の分だけ枠を狭めるため、その分広げて問い合わせる
+	calendars, err := s.busyCalendars(req.CalendarIds, start.Add(-buffer), end.Add(buffer))
+	if err != nil {
+		return nil, err
+	}
+
+	available := []freebusy.Interval{{Start: start, End: end}}
+	var busy []freebusy.Interval
+	for _, cb := range calendars {
+		if workingHours != nil {
+			available = freebusy.Intersect(available, workingHours.Intervals(recurrence.Location(cb.Calendar.Timezone), start, end))
+		}
+		busy = append(busy, cb.Busy...)
+	}
+	free := freebusy.Subtract(available, freebusy.Pad(freebusy.Merge(busy), buffer))
+
+	// 枠の開始時刻は依頼者のタイムゾーンの壁時計時刻で揃える
+	timezone := req.Timezone
+	if timezone == "" && len(calendars) > 0 {
+		timezone = calendars[0].Calendar.Timezone
+	}
+
+	return &pb.FindAvailableSlotsResponse{
+		Slots: intervalsToProto(freebusy.Slots(free, duration, slotStep, recurrence.Location(timezone), maxResults)),
+	}, nil
+}
+
+// parseWorkingHours は勤務時間帯の指定を解析する。未指定の場合はnil（終日）を返す
+func parseWorkingHours(wh *pb.WorkingHours) (*freebusy.WorkingHours, error) {
+	if wh == nil {
+		return nil, nil
+	}
+
+	start, err := parseClock(wh.Start)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid working_hours.start: %v", err)
+	}
+	end, err := parseClock(wh.End)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid working_hours.end: %v", err)
+	}
+	if start >= end {
+		return nil, status.Error(codes.InvalidArgument, "working_hours.start must be before working_hours.end")
+	}
+
+	result := &freebusy.WorkingHours{Start: start, End: end, Days: defaultWorkingDays}
+	if len(wh.Days) > 0 {
+		result.Days = nil
+		for _, d := range wh.Days {
+			day, ok := weekdays[d]
+			if !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid working_hours.days: %q (use MO, TU, WE, TH, FR, SA or SU)", d)
+			}
+			result.Days = append(result.Days, day)
+		}
+	}
+	return result, nil
+}
+
+// parseClock は "HH:MM" 形式の時刻を0時からの経過時間に変換する（24:00まで）
+func parseClock(s string) (time.Duration, error) {
+	var hour, minute int
+	if n, err := fmt.Sscanf(s, "%d:%d", &hour, &minute); err != nil || n != 2 || len(s) != len("15:04") {
+		return 0, fmt.Errorf("%q is not in HH:MM format", s)
+	}
+	if hour < 0 || minute < 0 || minute > 59 || hour*60+minute > 24*60 {
+		return 0, fmt.Errorf("%q is out of range", s)
+	}
+	return time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute, nil
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/recurrence-scheduler/internal/recurrence"
+)
+
+// validateRRule は繰り返しルールをDTSTARTと合わせて検証する
+// 問題があればgoogle.rpc.BadRequestのフィールド違反を付けたInvalidArgumentを返す
+func validateRRule(rruleStr string, dtStart time.Time) error {
+	violations := recurrence.Validate(rruleStr, dtStart)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	fieldViolations := make([]*errdetails.BadRequest_FieldViolation, len(violations))
+	for i, v := range violations {
+		fieldViolations[i] = fieldViolation(v.Field, v.Description)
+	}
+	return badRequest(fieldViolations...)
+}
+
+func fieldViolation(field, description string) *errdetails.BadRequest_FieldViolation {
+	return &errdetails.BadRequest_FieldViolation{Field: field, Description: description}
+}
+
+// badRequest はフィールド違反を詳細に含むInvalidArgumentエラーを作成する
+// REST gatewayではレスポンスのdetailsとしてそのまま返される
+func badRequest(violations ...*errdetails.BadRequest_FieldViolation) error {
+	return invalidArgument("invalid recurrence rule", violations...)
+}
+
+// invalidArgument はmessageにフィールド違反の説明を続けたInvalidArgumentエラーを作成する
+func invalidArgument(message string, violations ...*errdetails.BadRequest_FieldViolation) error {
+	descriptions := make([]string, len(violations))
+	for i, v := range violations {
+		descriptions[i] = v.Field + ": " + v.Description
+	}
+
+	st := status.New(codes.InvalidArgument, message+": "+strings.Join(descriptions, "; "))
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidateRRuleDetails(t *testing.T) {
+	dtStart := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	if err := validateRRule("FREQ=DAILY;COUNT=3", dtStart); err != nil {
+		t.Fatalf("valid rule: %v", err)
+	}
+
+	err := validateRRule("FREQ=WEEKLY;BYDAY=1MO;UNTIL=20250101T000000Z", dtStart)
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("code = %v, want %v", st.Code(), codes.InvalidArgument)
+	}
+
+	var fields []string
+	for _, detail := range st.Details() {
+		if br, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range br.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+		}
+	}
+	if len(fields) != 2 || fields[0] != "rrule.until" || fields[1] != "rrule.byday" {
+		t.Errorf("field violations = %q, want rrule.until and rrule.byday", fields)
+	}
+}
@@ -0,0 +1,209 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/recurrence-scheduler/internal/ical"
+	"github.com/recurrence-scheduler/internal/models"
+	pb "github.com/recurrence-scheduler/proto/scheduler/v1"
+)
+
+// watchKeepAlive はSSEで変更がない間にコメント行を送る間隔
+// プロキシにアイドルな接続として切断されないようにする
+const watchKeepAlive = 30 * time.Second
+
+// WatchEvents はカレンダーのイベントの作成・更新・削除を発生順にストリームで送り続ける
+// resume_tokenを指定すると、そのトークンを受け取った変更の後から再送するため、再接続しても変更を取りこぼさない
+// 指定しない場合は呼び出し以降の変更だけを送る
+func (s *Server) WatchEvents(req *pb.WatchEventsRequest, stream pb.SchedulerService_WatchEventsServer) error {
+	since, err := s.watchStart(req.CalendarId, req.ResumeToken)
+	if err != nil {
+		return err
+	}
+	return s.watch(stream.Context(), req.CalendarId, since, languageFromContext(stream.Context()), stream.Send, nil)
+}
+
+// ServeWatch は GET /api/v1/calendars/{id}/watch をServer-Sent Eventsで処理する（WatchEventsのHTTP版）
+// 各イベントのidは再開用のトークンで、再接続時のLast-Event-IDヘッダー（またはresume_token）で続きから受け取れる
+func (s *Server) ServeWatch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/v1/calendars/"), "/watch")
+	token := r.Header.Get("Last-Event-ID")
+	if token == "" {
+		token = r.URL.Query().Get("resume_token")
+	}
+	since, err := s.watchStart(id, token)
+	if err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	// nginxなどのプロキシにバッファリングさせない
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	send := func(change *pb.EventChange) error {
+		data, err := protojson.Marshal(change)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", change.ResumeToken, sseEventName(change.ChangeType), data); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	}
+	keepAlive := func() error {
+		if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	}
+
+	lang := languageFromHeader(r.Header.Get("Accept-Language"))
+	if err := s.watch(r.Context(), id, since, lang, send, keepAlive); err != nil {
+		// ストリームの開始後はステータスコードを変えられないため、errorイベントで伝えて終了する
+		fmt.Fprintf(w, "event: error\ndata: %s\n\n", strconv.Quote(status.Convert(err).Message()))
+		flusher.Flush()
+	}
+}
+
+// watchStart はカレンダーの存在を確認し、変更を送り始める位置を返す
+// resumeTokenが空の場合は現在の最新の変更の位置を返す
+func (s *Server) watchStart(calendarID, resumeToken string) (int64, error) {
+	if _, err := s.storage.GetCalendar(calendarID); err != nil {
+		return 0, status.Error(codes.NotFound, "calendar not found")
+	}
+	if resumeToken != "" {
+		return decodeResumeToken(calendarID, resumeToken)
+	}
+
+	seq, err := s.storage.LatestChange(calendarID)
+	if err != nil {
+		return 0, status.Error(codes.Internal, err.Error())
+	}
+	return seq, nil
+}
+
+// watch はsinceより後の変更をsendに渡し、ctxが終了するまで新しい変更を待って送り続ける
+// keepAliveを指定すると、変更がない間watchKeepAliveごとに呼び出す。イベントの繰り返しルールの説明はlangで書く
+func (s *Server) watch(ctx context.Context, calendarID string, since int64, lang language, send func(*pb.EventChange) error, keepAlive func() error) error {
+	// 読み出しと購読の間に記録された変更を取りこぼさないよう、先に購読する
+	notify, stop := s.storage.Watch(calendarID)
+	defer stop()
+
+	var tick <-chan time.Time
+	if keepAlive != nil {
+		ticker := time.NewTicker(watchKeepAlive)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		changes, err := s.storage.ListChanges(calendarID, since)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		for _, change := range changes {
+			if err := send(s.changeToProto(change, lang)); err != nil {
+				return err
+			}
+			since = change.Seq
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-tick:
+			if err := keepAlive(); err != nil {
+				return err
+			}
+			continue
+		case <-notify:
+		}
+
+		if _, err := s.storage.GetCalendar(calendarID); err != nil {
+			return status.Error(codes.NotFound, "calendar was deleted")
+		}
+	}
+}
+
+// changeToProto は変更履歴をprotoに変換する
+// 作成・更新の場合は送信時点のイベントを含める（その後削除されていれば含めない）
+func (s *Server) changeToProto(change *models.Change, lang language) *pb.EventChange {
+	pbChange := &pb.EventChange{
+		CalendarId:  change.CalendarID,
+		EventId:     change.EventID,
+		Uid:         change.UID,
+		ChangedAt:   change.ChangedAt.UTC().Format(time.RFC3339),
+		ChangeType:  changeTypeToProto(change.Type),
+		ResumeToken: encodeResumeToken(change.CalendarID, change.Seq),
+	}
+
+	if change.Type != models.ChangeDeleted {
+		if event, err := s.storage.GetEvent(change.EventID); err == nil {
+			pbChange.Event = eventToProto(event, lang)
+			pbChange.Uid = ical.UID(event)
+		}
+	}
+	if pbChange.Uid == "" {
+		pbChange.Uid = change.EventID
+	}
+	return pbChange
+}
+
+// sseEventName はSSEのevent欄に使う変更の種別名
+func sseEventName(t pb.ChangeType) string {
+	switch t {
+	case pb.ChangeType_CHANGE_TYPE_CREATED:
+		return string(models.ChangeCreated)
+	case pb.ChangeType_CHANGE_TYPE_DELETED:
+		return string(models.ChangeDeleted)
+	}
+	return string(models.ChangeUpdated)
+}
+
+// encodeResumeToken は変更の番号を再開用のトークンにする
+// 別のカレンダーのトークンを拒否できるようカレンダーIDを含める
+func encodeResumeToken(calendarID string, seq int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(calendarID + ":" + strconv.FormatInt(seq, 10)))
+}
+
+func decodeResumeToken(calendarID, token string) (int64, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, status.Error(codes.InvalidArgument, "invalid resume_token")
+	}
+	id, seqStr, ok := strings.Cut(string(data), ":")
+	seq, err := strconv.ParseInt(seqStr, 10, 64)
+	if !ok || err != nil || seq < 0 {
+		return 0, status.Error(codes.InvalidArgument, "invalid resume_token")
+	}
+	if id != calendarID {
+		return 0, status.Error(codes.InvalidArgument, "resume_token belongs to another calendar")
+	}
+	return seq, nil
+}
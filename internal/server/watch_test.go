@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/recurrence-scheduler/internal/models"
+	pb "github.com/recurrence-scheduler/proto/scheduler/v1"
+)
+
+// startWatch はsinceより後の変更を受け取るwatchを開始し、受け取った変更を流すチャネルを返す
+// 返す関数でwatchの終了を待って止める。止めていなければテストの終了時に止める
+func startWatch(t *testing.T, s *Server, calendarID string, since int64) (<-chan *pb.EventChange, func()) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	changes := make(chan *pb.EventChange, 16)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		err := s.watch(ctx, calendarID, since, english, func(c *pb.EventChange) error {
+			changes <- c
+			return nil
+		}, nil)
+		if err != nil {
+			t.Errorf("watch: %v", err)
+		}
+	}()
+	stop := func() {
+		cancel()
+		<-done
+	}
+	t.Cleanup(stop)
+	return changes, stop
+}
+
+// receive はn件の変更を受け取って返す
+func receive(t *testing.T, changes <-chan *pb.EventChange, n int) []*pb.EventChange {
+	t.Helper()
+	var got []*pb.EventChange
+	for len(got) < n {
+		select {
+		case c := <-changes:
+			got = append(got, c)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("received %d changes, want %d", len(got), n)
+		}
+	}
+	return got
+}
+
+// assertChanges は変更を「種別 イベントID」の形で比べる
+func assertChanges(t *testing.T, got []*pb.EventChange, want ...string) {
+	t.Helper()
+	for i, c := range got {
+		if s := sseEventName(c.ChangeType) + " " + c.EventId; s != want[i] {
+			t.Errorf("change %d = %s, want %s", i, s, want[i])
+		}
+	}
+}
+
+func TestWatchResume(t *testing.T) {
+	s, st, cal := newTestServer(t)
+	start := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	first := models.NewEvent(cal.ID, "first", "", start, start.Add(time.Hour), "", "UTC")
+	second := models.NewEvent(cal.ID, "second", "", start, start.Add(time.Hour), "", "UTC")
+
+	// 変更のないカレンダーから見始める
+	since, err := s.watchStart(cal.ID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes, stop := startWatch(t, s, cal.ID, since)
+	if err := st.CreateEvent(first); err != nil {
+		t.Fatal(err)
+	}
+	first.Title = "renamed"
+	if err := st.UpdateEvent(first); err != nil {
+		t.Fatal(err)
+	}
+	got := receive(t, changes, 2)
+	assertChanges(t, got, "created "+first.ID, "updated "+first.ID)
+	if got[1].Event.GetTitle() != "renamed" {
+		t.Errorf("updated event title = %q, want renamed", got[1].Event.GetTitle())
+	}
+	stop()
+
+	// 切断中の変更も、最初の変更のトークンから再開すれば順に受け取れる
+	if err := st.CreateEvent(second); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.DeleteEvent(first.ID); err != nil {
+		t.Fatal(err)
+	}
+	since, err = s.watchStart(cal.ID, got[0].ResumeToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes, stop = startWatch(t, s, cal.ID, since)
+	resumed := receive(t, changes, 3)
+	assertChanges(t, resumed, "updated "+first.ID, "created "+second.ID, "deleted "+first.ID)
+	stop()
+
+	// トークンを指定しない場合は呼び出し以降の変更だけを受け取る
+	since, err = s.watchStart(cal.ID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes, _ = startWatch(t, s, cal.ID, since)
+	third := models.NewEvent(cal.ID, "third", "", start, start.Add(time.Hour), "", "UTC")
+	if err := st.CreateEvent(third); err != nil {
+		t.Fatal(err)
+	}
+	assertChanges(t, receive(t, changes, 1), "created "+third.ID)
+}
+
+func TestWatchStartErrors(t *testing.T) {
+	s, st, cal := newTestServer(t)
+	other := models.NewCalendar("other", "", "UTC")
+	if err := st.CreateCalendar(other); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		calendarID string
+		token      string
+		want       codes.Code
+	}{
+		{name: "unknown calendar", calendarID: "missing", want: codes.NotFound},
+		{name: "not base64", calendarID: cal.ID, token: "!!!", want: codes.InvalidArgument},
+		{name: "negative sequence", calendarID: cal.ID, token: encodeResumeToken(cal.ID, -1), want: codes.InvalidArgument},
+		{name: "another calendar", calendarID: cal.ID, token: encodeResumeToken(other.ID, 1), want: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := s.watchStart(tt.calendarID, tt.token); status.Code(err) != tt.want {
+				t.Errorf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	if seq, err := decodeResumeToken(cal.ID, encodeResumeToken(cal.ID, 42)); err != nil || seq != 42 {
+		t.Errorf("round trip = %d, %v; want 42", seq, err)
+	}
+}
@@ -0,0 +1,269 @@
+package server
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/recurrence-scheduler/internal/models"
+	"github.com/recurrence-scheduler/internal/storage"
+	pb "github.com/recurrence-scheduler/proto/scheduler/v1"
+)
+
+// maxWebhooksPerCalendar は1つのカレンダーに登録できるWebhookの上限
+// イベントの書き込みごとにWebhookの数だけ配信を登録するため、上限を設ける
+const maxWebhooksPerCalendar = 20
+
+// webhookSecretPrefix は署名の鍵であることを見分けやすくするための接頭辞
+const webhookSecretPrefix = "whsec_"
+
+// CreateWebhook はカレンダーのイベントの変更を通知するWebhookを登録する
+// 署名の鍵はこのレスポンスでのみ返す
+func (s *Server) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookResponse, error) {
+	if _, err := s.storage.GetCalendar(req.CalendarId); err != nil {
+		return nil, status.Error(codes.NotFound, "calendar not found")
+	}
+	if err := validateWebhookURL(req.Url); err != nil {
+		return nil, err
+	}
+	eventTypes, err := changeTypesFromProto(req.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.storage.ListWebhooks(req.CalendarId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if len(existing) >= maxWebhooksPerCalendar {
+		return nil, status.Errorf(codes.ResourceExhausted, "a calendar can have at most %d webhooks", maxWebhooksPerCalendar)
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	webhook := models.NewWebhook(req.CalendarId, req.Url, webhookSecretPrefix+token, eventTypes)
+	if err := s.storage.CreateWebhook(webhook); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbWebhook := webhookToProto(webhook)
+	pbWebhook.Secret = webhook.Secret
+	return &pb.CreateWebhookResponse{Webhook: pbWebhook}, nil
+}
+
+// ListWebhooks はカレンダーに登録されたWebhookを返す（署名の鍵は含めない）
+func (s *Server) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	if _, err := s.storage.GetCalendar(req.CalendarId); err != nil {
+		return nil, status.Error(codes.NotFound, "calendar not found")
+	}
+
+	webhooks, err := s.storage.ListWebhooks(req.CalendarId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var pbWebhooks []*pb.Webhook
+	for _, webhook := range webhooks {
+		pbWebhooks = append(pbWebhooks, webhookToProto(webhook))
+	}
+	return &pb.ListWebhooksResponse{Webhooks: pbWebhooks}, nil
+}
+
+// DeleteWebhook はWebhookを削除する。未送信の配信は送られなくなる
+func (s *Server) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	if _, err := s.storage.GetWebhook(req.WebhookId); err != nil {
+		return nil, status.Error(codes.NotFound, "webhook not found")
+	}
+
+	if err := s.storage.DeleteWebhook(req.WebhookId); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.DeleteWebhookResponse{}, nil
+}
+
+// ListWebhookDeliveries はWebhookの配信を新しい順に、送信ごとの結果（配信ログ）とともに返す
+// statusを指定するとその状態の配信だけを返す
+func (s *Server) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	if _, err := s.storage.GetWebhook(req.WebhookId); err != nil {
+		return nil, status.Error(codes.NotFound, "webhook not found")
+	}
+
+	var deliveryStatus models.DeliveryStatus
+	switch req.Status {
+	case pb.DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED:
+	case pb.DeliveryStatus_DELIVERY_STATUS_PENDING:
+		deliveryStatus = models.DeliveryPending
+	case pb.DeliveryStatus_DELIVERY_STATUS_DELIVERED:
+		deliveryStatus = models.DeliveryDelivered
+	case pb.DeliveryStatus_DELIVERY_STATUS_DEAD:
+		deliveryStatus = models.DeliveryDead
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid status")
+	}
+
+	filter := req.WebhookId + "|" + string(deliveryStatus)
+	deliveries, next, err := s.listDeliveries(req.PageSize, req.PageToken, filter, func(after storage.Cursor, limit int) ([]*models.WebhookDelivery, error) {
+		return s.storage.ListDeliveries(req.WebhookId, deliveryStatus, after, limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListWebhookDeliveriesResponse{Deliveries: deliveries, NextPageToken: next}, nil
+}
+
+// ListDeadLetters はカレンダー内で再送の上限に達した配信を新しい順に返す
+func (s *Server) ListDeadLetters(ctx context.Context, req *pb.ListDeadLettersRequest) (*pb.ListDeadLettersResponse, error) {
+	if _, err := s.storage.GetCalendar(req.CalendarId); err != nil {
+		return nil, status.Error(codes.NotFound, "calendar not found")
+	}
+
+	deliveries, next, err := s.listDeliveries(req.PageSize, req.PageToken, req.CalendarId, func(after storage.Cursor, limit int) ([]*models.WebhookDelivery, error) {
+		return s.storage.ListDeadLetters(req.CalendarId, after, limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListDeadLettersResponse{Deliveries: deliveries, NextPageToken: next}, nil
+}
+
+// listDeliveries はlistで読み出した配信の1ページ分を配信ログとともにprotoに変換し、次のページのトークンを返す
+func (s *Server) listDeliveries(pageSize int32, token, filter string, list func(after storage.Cursor, limit int) ([]*models.WebhookDelivery, error)) ([]*pb.WebhookDelivery, string, error) {
+	size := normalizePageSize(pageSize)
+	cursor, err := decodePageToken(token, filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// 続きがあるかを判定するため1件多く取得する
+	deliveries, err := list(cursor, size+1)
+	if err != nil {
+		return nil, "", status.Error(codes.Internal, err.Error())
+	}
+
+	var next string
+	if len(deliveries) > size {
+		deliveries = deliveries[:size]
+		next = encodePageToken(storage.DeliveryCursor(deliveries[size-1]), filter)
+	}
+
+	var pbDeliveries []*pb.WebhookDelivery
+	for _, delivery := range deliveries {
+		attempts, err := s.storage.ListAttempts(delivery.ID)
+		if err != nil {
+			return nil, "", status.Error(codes.Internal, err.Error())
+		}
+		pbDeliveries = append(pbDeliveries, deliveryToProto(delivery, attempts))
+	}
+	return pbDeliveries, next, nil
+}
+
+// validateWebhookURL は送信先がホストを含むhttp(s)の絶対URLかを検証する
+func validateWebhookURL(raw string) error {
+	if raw == "" {
+		return status.Error(codes.InvalidArgument, "url is required")
+	}
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return status.Error(codes.InvalidArgument, "url must be an absolute http or https URL")
+	}
+	if u.User != nil {
+		return status.Error(codes.InvalidArgument, "url must not contain credentials")
+	}
+	return nil
+}
+
+// changeTypesFromProto は通知の対象にする変更の種別を変換する（重複は取り除く）
+func changeTypesFromProto(types []pb.ChangeType) ([]models.ChangeType, error) {
+	var result []models.ChangeType
+	seen := make(map[models.ChangeType]bool)
+	for _, t := range types {
+		var ct models.ChangeType
+		switch t {
+		case pb.ChangeType_CHANGE_TYPE_CREATED:
+			ct = models.ChangeCreated
+		case pb.ChangeType_CHANGE_TYPE_UPDATED:
+			ct = models.ChangeUpdated
+		case pb.ChangeType_CHANGE_TYPE_DELETED:
+			ct = models.ChangeDeleted
+		case pb.ChangeType_CHANGE_TYPE_OCCURRENCE_STARTED:
+			ct = models.OccurrenceStarted
+		case pb.ChangeType_CHANGE_TYPE_REMINDER_DUE:
+			ct = models.ReminderDue
+		default:
+			return nil, status.Error(codes.InvalidArgument, "invalid event_types: "+t.String())
+		}
+		if !seen[ct] {
+			seen[ct] = true
+			result = append(result, ct)
+		}
+	}
+	return result, nil
+}
+
+// changeTypeToProto は変更の種別をprotoに変換する
+func changeTypeToProto(t models.ChangeType) pb.ChangeType {
+	switch t {
+	case models.ChangeCreated:
+		return pb.ChangeType_CHANGE_TYPE_CREATED
+	case models.ChangeDeleted:
+		return pb.ChangeType_CHANGE_TYPE_DELETED
+	case models.OccurrenceStarted:
+		return pb.ChangeType_CHANGE_TYPE_OCCURRENCE_STARTED
+	case models.ReminderDue:
+		return pb.ChangeType_CHANGE_TYPE_REMINDER_DUE
+	}
+	return pb.ChangeType_CHANGE_TYPE_UPDATED
+}
+
+func webhookToProto(webhook *models.Webhook) *pb.Webhook {
+	pbWebhook := &pb.Webhook{
+		Id:         webhook.ID,
+		CalendarId: webhook.CalendarID,
+		Url:        webhook.URL,
+		CreatedAt:  webhook.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	for _, t := range webhook.EventTypes {
+		pbWebhook.EventTypes = append(pbWebhook.EventTypes, changeTypeToProto(t))
+	}
+	return pbWebhook
+}
+
+func deliveryToProto(delivery *models.WebhookDelivery, attempts []*models.WebhookAttempt) *pb.WebhookDelivery {
+	pbDelivery := &pb.WebhookDelivery{
+		Id:         delivery.ID,
+		WebhookId:  delivery.WebhookID,
+		CalendarId: delivery.CalendarID,
+		EventId:    delivery.EventID,
+		ChangeType: changeTypeToProto(delivery.Type),
+		Payload:    string(delivery.Payload),
+		Attempts:   int32(delivery.Attempts),
+		LastError:  delivery.LastError,
+		CreatedAt:  delivery.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:  delivery.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+
+	switch delivery.Status {
+	case models.DeliveryPending:
+		pbDelivery.Status = pb.DeliveryStatus_DELIVERY_STATUS_PENDING
+		pbDelivery.NextAttemptAt = delivery.NextAttemptAt.UTC().Format(time.RFC3339)
+	case models.DeliveryDelivered:
+		pbDelivery.Status = pb.DeliveryStatus_DELIVERY_STATUS_DELIVERED
+	case models.DeliveryDead:
+		pbDelivery.Status = pb.DeliveryStatus_DELIVERY_STATUS_DEAD
+	}
+
+	for _, attempt := range attempts {
+		pbDelivery.AttemptLog = append(pbDelivery.AttemptLog, &pb.WebhookAttempt{
+			AttemptedAt: attempt.AttemptedAt.UTC().Format(time.RFC3339),
+			StatusCode:  int32(attempt.StatusCode),
+			Error:       attempt.Error,
+			DurationMs:  attempt.Duration.Milliseconds(),
+		})
+	}
+	return pbDelivery
+}
@@ -0,0 +1,124 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/recurrence-scheduler/internal/models"
+)
+
+// recordChange はイベントの作成・更新を変更履歴に記録する
+// オーバーライドは元の繰り返しイベントの更新として記録する
+func recordChange(db execer, event *models.Event, changeType models.ChangeType) error {
+	eventID := event.ID
+	if event.IsOverride() {
+		eventID = event.RecurringEventID
+		changeType = models.ChangeUpdated
+	}
+
+	_, err := db.Exec(
+		`INSERT INTO event_changes (calendar_id, event_id, deleted, change_type, changed_at) VALUES (?, ?, 0, ?, ?)`,
+		event.CalendarID, eventID, changeType, time.Now().Format(time.RFC3339),
+	)
+	return err
+}
+
+// recordDeletion はイベントの削除を変更履歴に記録する
+// 削除後もクライアントが対象を特定できるよう、UIDとリソース名を残す
+func recordDeletion(db execer, event *models.Event) error {
+	_, err := db.Exec(
+		`INSERT INTO event_changes (calendar_id, event_id, uid, resource_name, deleted, change_type, changed_at) VALUES (?, ?, ?, ?, 1, ?, ?)`,
+		event.CalendarID, event.ID, event.UID, event.ResourceName, models.ChangeDeleted, time.Now().Format(time.RFC3339),
+	)
+	return err
+}
+
+// ListChanges はsinceより後の変更履歴を取得
+func (s *SQLiteStorage) ListChanges(calendarID string, since int64) ([]*models.Change, error) {
+	rows, err := s.db.Query(
+		`SELECT seq, calendar_id, event_id, uid, resource_name, deleted, change_type, changed_at
+		 FROM event_changes WHERE calendar_id = ? AND seq > ? ORDER BY seq`,
+		calendarID, since,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var changes []*models.Change
+	for rows.Next() {
+		var change models.Change
+		var changedAt string
+
+		if err := rows.Scan(&change.Seq, &change.CalendarID, &change.EventID, &change.UID, &change.ResourceName,
+			&change.Deleted, &change.Type, &changedAt); err != nil {
+			return nil, err
+		}
+		// 種別を記録する前の変更は、削除以外を更新として扱う
+		if change.Type == "" {
+			change.Type = models.ChangeUpdated
+			if change.Deleted {
+				change.Type = models.ChangeDeleted
+			}
+		}
+
+		change.ChangedAt, _ = time.Parse(time.RFC3339, changedAt)
+		changes = append(changes, &change)
+	}
+
+	return changes, rows.Err()
+}
+
+// LatestChange は最新の変更番号を取得
+func (s *SQLiteStorage) LatestChange(calendarID string) (int64, error) {
+	var seq int64
+	err := s.db.QueryRow(`SELECT COALESCE(MAX(seq), 0) FROM event_changes WHERE calendar_id = ?`, calendarID).Scan(&seq)
+	return seq, err
+}
+
+// LatestChangeTime はカレンダーの最新の変更履歴の番号と日時を取得
+func (s *SQLiteStorage) LatestChangeTime(calendarID string) (int64, time.Time, error) {
+	var seq int64
+	var changedAt string
+	err := s.db.QueryRow(
+		`SELECT seq, changed_at FROM event_changes WHERE calendar_id = ? ORDER BY seq DESC LIMIT 1`, calendarID,
+	).Scan(&seq, &changedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, time.Time{}, nil
+	}
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	t, _ := time.Parse(time.RFC3339, changedAt)
+	return seq, t, nil
+}
+
+// SaveSeries は繰り返しイベントを作成または更新し、オーバーライドをすべて置き換える
+func (s *SQLiteStorage) SaveSeries(master *models.Event, overrides []*models.Event) error {
+	return s.notifyAfter(master.CalendarID, s.withTx(func(tx *sql.Tx) error {
+		var count int
+		if err := tx.QueryRow(`SELECT COUNT(*) FROM events WHERE id = ?`, master.ID).Scan(&count); err != nil {
+			return err
+		}
+
+		if count > 0 {
+			if err := updateEvent(tx, master); err != nil {
+				return err
+			}
+		} else if err := insertEvent(tx, master); err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(`DELETE FROM events WHERE recurring_event_id = ?`, master.ID); err != nil {
+			return err
+		}
+		for _, override := range overrides {
+			if err := insertEvent(tx, override); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}))
+}
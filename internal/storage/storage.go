@@ -2,6 +2,10 @@ package storage
 
 import (
 	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/recurrence-scheduler/internal/models"
@@ -13,22 +17,130 @@ type Storage interface {
 	// カレンダー操作
 	CreateCalendar(cal *models.Calendar) error
 	GetCalendar(id string) (*models.Calendar, error)
-	ListCalendars(limit, offset int) ([]*models.Calendar, error)
+	// ListCalendars は作成日時の新しい順にafterより後のカレンダーを返す（limitが負の場合は件数を制限しない）
+	ListCalendars(after Cursor, limit int) ([]*models.Calendar, error)
+	CountCalendars() (int, error)
+	UpdateCalendar(cal *models.Calendar) error
+	// SetFeedToken はカレンダーの購読用トークンを設定する（空文字列で無効化）
+	SetFeedToken(calendarID, token string) error
+	GetCalendarByFeedToken(token string) (*models.Calendar, error)
+	DeleteCalendar(id string, cascade bool) error
 
 	// イベント操作
 	CreateEvent(event *models.Event) error
 	GetEvent(id string) (*models.Event, error)
-	ListEvents(calendarID string, start, end time.Time, limit, offset int) ([]*models.Event, error)
+	// GetEventByUID はカレンダー内でUIDが一致する繰り返しの親または単発のイベントを返す
+	// UIDを持たないイベントはIDをUIDとして照合する
+	GetEventByUID(calendarID, uid string) (*models.Event, error)
+	GetEventByResourceName(calendarID, name string) (*models.Event, error)
+	// ListEvents は期間と重なる単発イベントと、期間終了までに開始する繰り返しイベントを
+	// 開始日時順にafterより後から返す（limitが負の場合は件数を制限しない）
+	ListEvents(calendarID string, start, end time.Time, after Cursor, limit int) ([]*models.Event, error)
+	// CountEvents はListEventsが返すイベントの総数を返す
+	CountEvents(calendarID string, start, end time.Time) (int, error)
+	// ListCalendarEvents はオーバーライドを含むカレンダー内のすべてのイベントを返す
+	ListCalendarEvents(calendarID string) ([]*models.Event, error)
+	UpdateEvent(event *models.Event) error
+	DeleteEvent(id string) error
+	SplitEvent(original, next *models.Event, moved map[string]*models.Event) error
+	// SaveSeries は繰り返しイベントとそのオーバーライドをまとめて保存する
+	// 既存のオーバーライドはoverridesで置き換える
+	SaveSeries(master *models.Event, overrides []*models.Event) error
+
+	// オーバーライド（RECURRENCE-ID）操作
+	ListOverrides(recurringEventID string) ([]*models.Event, error)
+	ListCalendarOverrides(calendarID string) ([]*models.Event, error)
+
+	// 変更履歴
+	// ListChanges はカレンダー内でsinceより後に記録された変更を記録順に返す
+	ListChanges(calendarID string, since int64) ([]*models.Change, error)
+	// LatestChange はカレンダー内で最後に記録された変更の番号を返す（変更がなければ0）
+	LatestChange(calendarID string) (int64, error)
+	// LatestChangeTime はカレンダー内で最後に記録された変更（削除を含む）の番号と日時を返す（変更がなければ0とゼロ値）
+	LatestChangeTime(calendarID string) (int64, time.Time, error)
+	// Watch はカレンダーに変更が記録されるたびに通知を受け取るチャネルを返す（stopで購読をやめる）
+	// calendarIDが空の場合はすべてのカレンダーの変更を通知する
+	Watch(calendarID string) (notify <-chan struct{}, stop func())
+
+	// Webhook
+	// イベントの変更は書き込みと同じトランザクションで、対象のWebhookごとの配信として登録される
+	CreateWebhook(webhook *models.Webhook) error
+	GetWebhook(id string) (*models.Webhook, error)
+	ListWebhooks(calendarID string) ([]*models.Webhook, error)
+	// DeleteWebhook はWebhookを配信と配信ログを含めて削除する
+	DeleteWebhook(id string) error
+	// ListDueDeliveries は送信時刻がnow以前の未送信の配信を送信時刻順に最大limit件返す
+	ListDueDeliveries(now time.Time, limit int) ([]*models.WebhookDelivery, error)
+	// RecordAttempt は送信結果を配信ログに追加し、配信の状態を更新する
+	RecordAttempt(delivery *models.WebhookDelivery, attempt *models.WebhookAttempt) error
+	// ListDeliveries はWebhookの配信を新しい順にafterより後から返す（statusが空の場合はすべての状態）
+	ListDeliveries(webhookID string, status models.DeliveryStatus, after Cursor, limit int) ([]*models.WebhookDelivery, error)
+	// ListDeadLetters はカレンダー内の再送の上限に達した配信を新しい順にafterより後から返す
+	ListDeadLetters(calendarID string, after Cursor, limit int) ([]*models.WebhookDelivery, error)
+	// ListAttempts は配信の送信結果を送信順に返す
+	ListAttempts(deliveryID string) ([]*models.WebhookAttempt, error)
+	// EnqueueOccurrenceWebhooks はインスタンスの開始・リマインダーを通知の対象にしているWebhookへの配信を登録する（同じ発火は1件のみ）
+	EnqueueOccurrenceWebhooks(firing *models.Firing) error
+
+	// インスタンスの開始とリマインダー（トリガー）
+	// RecordFiring は発火を記録してイベント（リマインダーの場合はリマインダー）の発火済みの時刻をfiredThroughまで進める
+	// 同じIDの発火が記録済みの場合はfalseを返す
+	RecordFiring(firing *models.Firing, firedThrough time.Time) (bool, error)
+	CompleteFiring(id string) error
+	// ListPendingFirings はアクションの実行が終わっていない発火を返す
+	ListPendingFirings() ([]*models.Firing, error)
+	// GetTriggerMark はイベントの発火済みの時刻を返す（まだ発火していなければゼロ値）
+	GetTriggerMark(eventID string) (time.Time, error)
+	// GetReminderMark はイベントのリマインダーの発火済みの時刻を返す（まだ発火していなければゼロ値）
+	GetReminderMark(eventID, reminderID string) (time.Time, error)
 }
 
+// Cursor はキーセットページネーションの位置で、最後に返した行の並び替えキーとIDを表す
+// 並び替えキーが同じ行はIDで順序を決めるため、途中で行が追加されても重複や欠落が起きない
+// ゼロ値は先頭を表す
+type Cursor struct {
+	Key string
+	ID  string
+}
+
+// IsZero はカーソルが先頭を表すかを返す
+func (c Cursor) IsZero() bool {
+	return c.Key == "" && c.ID == ""
+}
+
+// CalendarCursor はカレンダーの直後から続けるためのカーソルを返す
+func CalendarCursor(cal *models.Calendar) Cursor {
+	return Cursor{Key: cal.CreatedAt.UTC().Format(time.RFC3339), ID: cal.ID}
+}
+
+// EventCursor はイベントの直後から続けるためのカーソルを返す
+func EventCursor(event *models.Event) Cursor {
+	return Cursor{Key: event.DTStart.UTC().Format(time.RFC3339), ID: event.ID}
+}
+
+// ErrCalendarNotEmpty はイベントが残っているカレンダーを削除しようとした場合のエラー
+var ErrCalendarNotEmpty = errors.New("calendar has events")
+
 // SQLiteStorage はSQLite実装
+// dtstart/dtendは文字列比較で範囲検索できるようUTCで保存する
+// カレンダーのcreated_atもページの位置を文字列で比較するためUTCで保存する
 type SQLiteStorage struct {
-	db *sql.DB
+	db       *sql.DB
+	watchers watchers
 }
 
+// busyTimeout はほかの接続が書き込み中のときにロックの解放を待つ時間（ミリ秒）
+// WatchEventsやトリガーエンジンは書き込みと並行して読み出すため、待たずにSQLITE_BUSYを返さないようにする
+const busyTimeout = 5000
+
 // NewSQLiteStorage は新しいSQLiteストレージを作成
 func NewSQLiteStorage(dbPath string) (*SQLiteStorage, error) {
-	db, err := sql.Open("sqlite", dbPath)
+	// PRAGMAは接続ごとの設定のため、プールのすべての接続に適用されるよう接続文字列で指定する
+	sep := "?"
+	if strings.Contains(dbPath, "?") {
+		sep = "&"
+	}
+	db, err := sql.Open("sqlite", fmt.Sprintf("%s%s_pragma=busy_timeout(%d)", dbPath, sep, busyTimeout))
 	if err != nil {
 		return nil, err
 	}
@@ -49,6 +161,7 @@ func (s *SQLiteStorage) migrate() error {
 			name TEXT NOT NULL,
 			description TEXT,
 			timezone TEXT NOT NULL,
+			feed_token TEXT NOT NULL DEFAULT '',
 			created_at TEXT NOT NULL,
 			updated_at TEXT NOT NULL
 		)`,
@@ -60,13 +173,90 @@ func (s *SQLiteStorage) migrate() error {
 			dtstart TEXT NOT NULL,
 			dtend TEXT NOT NULL,
 			rrule TEXT,
+			exdates TEXT NOT NULL DEFAULT '',
+			rdates TEXT NOT NULL DEFAULT '',
 			timezone TEXT NOT NULL,
+			recurring_event_id TEXT NOT NULL DEFAULT '',
+			recurrence_id TEXT NOT NULL DEFAULT '',
+			related_to TEXT NOT NULL DEFAULT '',
+			uid TEXT NOT NULL DEFAULT '',
+			resource_name TEXT NOT NULL DEFAULT '',
+			reminders TEXT NOT NULL DEFAULT '',
+			all_day INTEGER NOT NULL DEFAULT 0,
 			created_at TEXT NOT NULL,
 			updated_at TEXT NOT NULL,
 			FOREIGN KEY (calendar_id) REFERENCES calendars(id)
 		)`,
 		`CREATE INDEX IF NOT EXISTS idx_events_calendar_id ON events(calendar_id)`,
 		`CREATE INDEX IF NOT EXISTS idx_events_dtstart ON events(dtstart)`,
+		`CREATE INDEX IF NOT EXISTS idx_events_calendar_dtstart ON events(calendar_id, dtstart, id)`,
+		`CREATE INDEX IF NOT EXISTS idx_calendars_created_at ON calendars(created_at, id)`,
+		`CREATE TABLE IF NOT EXISTS event_changes (
+			seq INTEGER PRIMARY KEY AUTOINCREMENT,
+			calendar_id TEXT NOT NULL,
+			event_id TEXT NOT NULL,
+			uid TEXT NOT NULL DEFAULT '',
+			resource_name TEXT NOT NULL DEFAULT '',
+			deleted INTEGER NOT NULL DEFAULT 0,
+			change_type TEXT NOT NULL DEFAULT '',
+			changed_at TEXT NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_event_changes_calendar_id ON event_changes(calendar_id, seq)`,
+		`CREATE TABLE IF NOT EXISTS webhooks (
+			id TEXT PRIMARY KEY,
+			calendar_id TEXT NOT NULL,
+			url TEXT NOT NULL,
+			secret TEXT NOT NULL,
+			event_types TEXT NOT NULL DEFAULT '',
+			created_at TEXT NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_webhooks_calendar_id ON webhooks(calendar_id)`,
+		`CREATE TABLE IF NOT EXISTS webhook_deliveries (
+			id TEXT PRIMARY KEY,
+			webhook_id TEXT NOT NULL,
+			calendar_id TEXT NOT NULL,
+			event_id TEXT NOT NULL,
+			change_type TEXT NOT NULL,
+			payload TEXT NOT NULL,
+			status TEXT NOT NULL,
+			attempts INTEGER NOT NULL DEFAULT 0,
+			next_attempt_at TEXT NOT NULL,
+			last_error TEXT NOT NULL DEFAULT '',
+			created_at TEXT NOT NULL,
+			updated_at TEXT NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries(status, next_attempt_at)`,
+		`CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_webhook_id ON webhook_deliveries(webhook_id, created_at, id)`,
+		`CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_calendar_id ON webhook_deliveries(calendar_id, status, created_at, id)`,
+		`CREATE TABLE IF NOT EXISTS webhook_attempts (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			delivery_id TEXT NOT NULL,
+			attempted_at TEXT NOT NULL,
+			status_code INTEGER NOT NULL DEFAULT 0,
+			error TEXT NOT NULL DEFAULT '',
+			duration_ms INTEGER NOT NULL DEFAULT 0
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_webhook_attempts_delivery_id ON webhook_attempts(delivery_id)`,
+		`CREATE TABLE IF NOT EXISTS occurrence_firings (
+			id TEXT PRIMARY KEY,
+			event_id TEXT NOT NULL,
+			calendar_id TEXT NOT NULL,
+			instance TEXT NOT NULL,
+			reminder TEXT NOT NULL DEFAULT '',
+			status TEXT NOT NULL,
+			fired_at TEXT NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_occurrence_firings_status ON occurrence_firings(status)`,
+		`CREATE TABLE IF NOT EXISTS trigger_marks (
+			event_id TEXT PRIMARY KEY,
+			fired_through TEXT NOT NULL
+		)`,
+		`CREATE TABLE IF NOT EXISTS reminder_marks (
+			event_id TEXT NOT NULL,
+			reminder_id TEXT NOT NULL,
+			fired_through TEXT NOT NULL,
+			PRIMARY KEY (event_id, reminder_id)
+		)`,
 	}
 
 	for _, q := range queries {
@@ -75,9 +265,160 @@ func (s *SQLiteStorage) migrate() error {
 		}
 	}
 
+	// 既存のデータベースに後から追加したカラム
+	columns := []struct{ table, column, definition string }{
+		{"calendars", "feed_token", "TEXT NOT NULL DEFAULT ''"},
+		{"events", "exdates", "TEXT NOT NULL DEFAULT ''"},
+		{"events", "rdates", "TEXT NOT NULL DEFAULT ''"},
+		{"events", "recurring_event_id", "TEXT NOT NULL DEFAULT ''"},
+		{"events", "recurrence_id", "TEXT NOT NULL DEFAULT ''"},
+		{"events", "related_to", "TEXT NOT NULL DEFAULT ''"},
+		{"events", "uid", "TEXT NOT NULL DEFAULT ''"},
+		{"events", "resource_name", "TEXT NOT NULL DEFAULT ''"},
+		{"events", "reminders", "TEXT NOT NULL DEFAULT ''"},
+		{"events", "all_day", "INTEGER NOT NULL DEFAULT 0"},
+		{"occurrence_firings", "reminder", "TEXT NOT NULL DEFAULT ''"},
+		{"event_changes", "change_type", "TEXT NOT NULL DEFAULT ''"},
+	}
+	for _, c := range columns {
+		if err := s.addColumnIfMissing(c.table, c.column, c.definition); err != nil {
+			return err
+		}
+	}
+
+	if err := s.normalizeEventTimes(); err != nil {
+		return err
+	}
+	if err := s.normalizeCalendarTimes(); err != nil {
+		return err
+	}
+
+	indexes := []string{
+		`CREATE INDEX IF NOT EXISTS idx_events_recurring_event_id ON events(recurring_event_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_events_uid ON events(calendar_id, uid)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_calendars_feed_token ON calendars(feed_token) WHERE feed_token != ''`,
+	}
+	for _, q := range indexes {
+		if _, err := s.db.Exec(q); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// normalizeEventTimes はUTC以外のオフセットで保存されたdtstart/dtendをUTCのRFC3339に書き換える
+// 範囲検索とページの位置の比較は文字列で行うため、古いデータベースの行もUTCにそろえておく
+func (s *SQLiteStorage) normalizeEventTimes() error {
+	rows, err := s.db.Query(`SELECT id, dtstart, dtend FROM events WHERE dtstart NOT LIKE '%Z' OR dtend NOT LIKE '%Z'`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	type eventTimes struct{ id, dtStart, dtEnd string }
+	var stale []eventTimes
+	for rows.Next() {
+		var t eventTimes
+		if err := rows.Scan(&t.id, &t.dtStart, &t.dtEnd); err != nil {
+			return err
+		}
+		stale = append(stale, t)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	if len(stale) == 0 {
+		return nil
+	}
+	return s.withTx(func(tx *sql.Tx) error {
+		for _, t := range stale {
+			dtStart, errStart := time.Parse(time.RFC3339, t.dtStart)
+			dtEnd, errEnd := time.Parse(time.RFC3339, t.dtEnd)
+			if errStart != nil || errEnd != nil {
+				continue
+			}
+			if _, err := tx.Exec(`UPDATE events SET dtstart = ?, dtend = ? WHERE id = ?`,
+				dtStart.UTC().Format(time.RFC3339), dtEnd.UTC().Format(time.RFC3339), t.id); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// normalizeCalendarTimes はUTC以外のオフセットで保存されたカレンダーのcreated_at/updated_atをUTCのRFC3339に書き換える
+// ListCalendarsはcreated_atを文字列で比較して並べるため、オフセットが混ざると順序とページの位置がずれる
+func (s *SQLiteStorage) normalizeCalendarTimes() error {
+	rows, err := s.db.Query(`SELECT id, created_at, updated_at FROM calendars WHERE created_at NOT LIKE '%Z' OR updated_at NOT LIKE '%Z'`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	type calendarTimes struct{ id, createdAt, updatedAt string }
+	var stale []calendarTimes
+	for rows.Next() {
+		var t calendarTimes
+		if err := rows.Scan(&t.id, &t.createdAt, &t.updatedAt); err != nil {
+			return err
+		}
+		stale = append(stale, t)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	if len(stale) == 0 {
+		return nil
+	}
+	return s.withTx(func(tx *sql.Tx) error {
+		for _, t := range stale {
+			createdAt, errCreated := time.Parse(time.RFC3339, t.createdAt)
+			updatedAt, errUpdated := time.Parse(time.RFC3339, t.updatedAt)
+			if errCreated != nil || errUpdated != nil {
+				continue
+			}
+			if _, err := tx.Exec(`UPDATE calendars SET created_at = ?, updated_at = ? WHERE id = ?`,
+				createdAt.UTC().Format(time.RFC3339), updatedAt.UTC().Format(time.RFC3339), t.id); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// addColumnIfMissing はカラムが存在しない場合のみALTER TABLEで追加する
+func (s *SQLiteStorage) addColumnIfMissing(table, column, definition string) error {
+	rows, err := s.db.Query(fmt.Sprintf(`PRAGMA table_info(%s)`, table))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var cid, notNull, pk int
+		var name, typ string
+		var dflt sql.NullString
+		if err := rows.Scan(&cid, &name, &typ, &notNull, &dflt, &pk); err != nil {
+			return err
+		}
+		if name == column {
+			return nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	_, err = s.db.Exec(fmt.Sprintf(`ALTER TABLE %s ADD COLUMN %s %s`, table, column, definition))
+	return err
+}
+
 // Close はデータベース接続を閉じる
 func (s *SQLiteStorage) Close() error {
 	return s.db.Close()
@@ -89,23 +430,20 @@ func (s *SQLiteStorage) CreateCalendar(cal *models.Calendar) error {
 		`INSERT INTO calendars (id, name, description, timezone, created_at, updated_at)
 		 VALUES (?, ?, ?, ?, ?, ?)`,
 		cal.ID, cal.Name, cal.Description, cal.Timezone,
-		cal.CreatedAt.Format(time.RFC3339), cal.UpdatedAt.Format(time.RFC3339),
+		cal.CreatedAt.UTC().Format(time.RFC3339), cal.UpdatedAt.UTC().Format(time.RFC3339),
 	)
 	return err
 }
 
-// GetCalendar はカレンダーを取得
-func (s *SQLiteStorage) GetCalendar(id string) (*models.Calendar, error) {
+// calendarColumns はcalendarsテーブルから読み出すカラム
+const calendarColumns = `id, name, description, timezone, feed_token, created_at, updated_at`
+
+// scanCalendar はcalendarColumnsの順で1行を読み出す
+func scanCalendar(row rowScanner) (*models.Calendar, error) {
 	var cal models.Calendar
 	var createdAt, updatedAt string
 
-	err := s.db.QueryRow(
-		`SELECT id, name, description, timezone, created_at, updated_at
-		 FROM calendars WHERE id = ?`,
-		id,
-	).Scan(&cal.ID, &cal.Name, &cal.Description, &cal.Timezone, &createdAt, &updatedAt)
-
-	if err != nil {
+	if err := row.Scan(&cal.ID, &cal.Name, &cal.Description, &cal.Timezone, &cal.FeedToken, &createdAt, &updatedAt); err != nil {
 		return nil, err
 	}
 
@@ -115,12 +453,26 @@ func (s *SQLiteStorage) GetCalendar(id string) (*models.Calendar, error) {
 	return &cal, nil
 }
 
+// GetCalendar はカレンダーを取得
+func (s *SQLiteStorage) GetCalendar(id string) (*models.Calendar, error) {
+	return scanCalendar(s.db.QueryRow(`SELECT `+calendarColumns+` FROM calendars WHERE id = ?`, id))
+}
+
+// GetCalendarByFeedToken は購読用トークンでカレンダーを取得
+func (s *SQLiteStorage) GetCalendarByFeedToken(token string) (*models.Calendar, error) {
+	if token == "" {
+		return nil, sql.ErrNoRows
+	}
+	return scanCalendar(s.db.QueryRow(`SELECT `+calendarColumns+` FROM calendars WHERE feed_token = ?`, token))
+}
+
 // ListCalendars はカレンダー一覧を取得
-func (s *SQLiteStorage) ListCalendars(limit, offset int) ([]*models.Calendar, error) {
+func (s *SQLiteStorage) ListCalendars(after Cursor, limit int) ([]*models.Calendar, error) {
 	rows, err := s.db.Query(
-		`SELECT id, name, description, timezone, created_at, updated_at
-		 FROM calendars ORDER BY created_at DESC LIMIT ? OFFSET ?`,
-		limit, offset,
+		`SELECT `+calendarColumns+`
+		 FROM calendars WHERE ? OR created_at < ? OR (created_at = ? AND id < ?)
+		 ORDER BY created_at DESC, id DESC LIMIT ?`,
+		after.IsZero(), after.Key, after.Key, after.ID, limit,
 	)
 	if err != nil {
 		return nil, err
@@ -129,66 +481,418 @@ func (s *SQLiteStorage) ListCalendars(limit, offset int) ([]*models.Calendar, er
 
 	var calendars []*models.Calendar
 	for rows.Next() {
-		var cal models.Calendar
-		var createdAt, updatedAt string
-
-		if err := rows.Scan(&cal.ID, &cal.Name, &cal.Description, &cal.Timezone, &createdAt, &updatedAt); err != nil {
+		cal, err := scanCalendar(rows)
+		if err != nil {
 			return nil, err
 		}
-
-		cal.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
-		cal.UpdatedAt, _ = time.Parse(time.RFC3339, updatedAt)
-
-		calendars = append(calendars, &cal)
+		calendars = append(calendars, cal)
 	}
 
 	return calendars, rows.Err()
 }
 
-// CreateEvent はイベントを作成
-func (s *SQLiteStorage) CreateEvent(event *models.Event) error {
-	_, err := s.db.Exec(
-		`INSERT INTO events (id, calendar_id, title, description, dtstart, dtend, rrule, timezone, created_at, updated_at)
-		 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
-		event.ID, event.CalendarID, event.Title, event.Description,
-		event.DTStart.Format(time.RFC3339), event.DTEnd.Format(time.RFC3339),
-		event.RRule, event.Timezone,
-		event.CreatedAt.Format(time.RFC3339), event.UpdatedAt.Format(time.RFC3339),
+// CountCalendars はカレンダーの総数を取得
+func (s *SQLiteStorage) CountCalendars() (int, error) {
+	var n int
+	err := s.db.QueryRow(`SELECT COUNT(*) FROM calendars`).Scan(&n)
+	return n, err
+}
+
+// UpdateCalendar はカレンダーを更新し、UpdatedAtを現在時刻にする
+func (s *SQLiteStorage) UpdateCalendar(cal *models.Calendar) error {
+	cal.UpdatedAt = time.Now()
+
+	res, err := s.db.Exec(
+		`UPDATE calendars SET name = ?, description = ?, timezone = ?, updated_at = ? WHERE id = ?`,
+		cal.Name, cal.Description, cal.Timezone, cal.UpdatedAt.UTC().Format(time.RFC3339), cal.ID,
 	)
-	return err
+	if err != nil {
+		return err
+	}
+
+	return requireAffected(res)
 }
 
-// GetEvent はイベントを取得
-func (s *SQLiteStorage) GetEvent(id string) (*models.Event, error) {
-	var event models.Event
-	var dtStart, dtEnd, createdAt, updatedAt string
+// SetFeedToken はカレンダーの購読用トークンを置き換える
+// トークンの変更はカレンダーの内容の変更ではないためUpdatedAtは更新しない
+func (s *SQLiteStorage) SetFeedToken(calendarID, token string) error {
+	res, err := s.db.Exec(`UPDATE calendars SET feed_token = ? WHERE id = ?`, token, calendarID)
+	if err != nil {
+		return err
+	}
 
-	err := s.db.QueryRow(
-		`SELECT id, calendar_id, title, description, dtstart, dtend, rrule, timezone, created_at, updated_at
-		 FROM events WHERE id = ?`,
-		id,
-	).Scan(&event.ID, &event.CalendarID, &event.Title, &event.Description,
-		&dtStart, &dtEnd, &event.RRule, &event.Timezone, &createdAt, &updatedAt)
+	return requireAffected(res)
+}
+
+// DeleteCalendar はカレンダーを削除
+// cascadeがfalseでイベントが残っている場合はErrCalendarNotEmptyを返す
+func (s *SQLiteStorage) DeleteCalendar(id string, cascade bool) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if cascade {
+		if err := deleteTriggerState(tx, `calendar_id = ?`, id); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(`DELETE FROM events WHERE calendar_id = ?`, id); err != nil {
+			return err
+		}
+	} else {
+		var count int
+		if err := tx.QueryRow(`SELECT COUNT(*) FROM events WHERE calendar_id = ?`, id).Scan(&count); err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrCalendarNotEmpty
+		}
+	}
+
+	if _, err := tx.Exec(`DELETE FROM event_changes WHERE calendar_id = ?`, id); err != nil {
+		return err
+	}
+	if err := deleteDeliveries(tx, `calendar_id = ?`, id); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`DELETE FROM webhooks WHERE calendar_id = ?`, id); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`DELETE FROM occurrence_firings WHERE calendar_id = ?`, id); err != nil {
+		return err
+	}
 
+	res, err := tx.Exec(`DELETE FROM calendars WHERE id = ?`, id)
 	if err != nil {
+		return err
+	}
+	if err := requireAffected(res); err != nil {
+		return err
+	}
+
+	// 購読者にはカレンダーが削除されたことを読み出しの失敗で伝える
+	return s.notifyAfter(id, tx.Commit())
+}
+
+// eventColumns はeventsテーブルから読み出すカラム
+const eventColumns = `id, calendar_id, title, description, dtstart, dtend, rrule, exdates, rdates, timezone,
+	recurring_event_id, recurrence_id, related_to, uid, resource_name, reminders, all_day, created_at, updated_at`
+
+// rowScanner は*sql.Rowと*sql.Rowsの共通インターフェース
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanEvent はeventColumnsの順で1行を読み出す
+func scanEvent(row rowScanner) (*models.Event, error) {
+	var event models.Event
+	var dtStart, dtEnd, exDates, rDates, recurrenceID, reminders, createdAt, updatedAt string
+
+	if err := row.Scan(&event.ID, &event.CalendarID, &event.Title, &event.Description,
+		&dtStart, &dtEnd, &event.RRule, &exDates, &rDates, &event.Timezone,
+		&event.RecurringEventID, &recurrenceID, &event.RelatedTo, &event.UID, &event.ResourceName, &reminders, &event.AllDay, &createdAt, &updatedAt); err != nil {
 		return nil, err
 	}
+	if reminders != "" {
+		if err := json.Unmarshal([]byte(reminders), &event.Reminders); err != nil {
+			return nil, err
+		}
+	}
 
 	event.DTStart, _ = time.Parse(time.RFC3339, dtStart)
 	event.DTEnd, _ = time.Parse(time.RFC3339, dtEnd)
+	event.ExDates = parseTimeList(exDates)
+	event.RDates = parseTimeList(rDates)
+	if recurrenceID != "" {
+		event.RecurrenceID, _ = time.Parse(time.RFC3339, recurrenceID)
+	}
 	event.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
 	event.UpdatedAt, _ = time.Parse(time.RFC3339, updatedAt)
 
 	return &event, nil
 }
 
+// formatTimeList は日時のリストをカンマ区切りのUTC RFC3339文字列にする
+func formatTimeList(ts []time.Time) string {
+	parts := make([]string, 0, len(ts))
+	for _, t := range ts {
+		parts = append(parts, t.UTC().Format(time.RFC3339))
+	}
+	return strings.Join(parts, ",")
+}
+
+// formatOptionalTime はゼロ値を空文字列として保存する
+func formatOptionalTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// formatReminders はリマインダーをJSONにする（リマインダーがなければ空文字列）
+// 発火済みの時刻と比較するため、絶対時刻は他の日時と同じく秒単位のUTCで保存する
+func formatReminders(reminders []models.Reminder) (string, error) {
+	if len(reminders) == 0 {
+		return "", nil
+	}
+	stored := make([]models.Reminder, len(reminders))
+	for i, reminder := range reminders {
+		if reminder.IsAbsolute() {
+			reminder.At = reminder.At.UTC().Truncate(time.Second)
+		}
+		stored[i] = reminder
+	}
+	b, err := json.Marshal(stored)
+	return string(b), err
+}
+
+// parseTimeList はformatTimeListの逆変換
+func parseTimeList(s string) []time.Time {
+	if s == "" {
+		return nil
+	}
+
+	var ts []time.Time
+	for _, part := range strings.Split(s, ",") {
+		if t, err := time.Parse(time.RFC3339, part); err == nil {
+			ts = append(ts, t)
+		}
+	}
+	return ts
+}
+
+// execer は*sql.DBと*sql.Txの共通インターフェース
+type execer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+// CreateEvent はイベントを作成
+func (s *SQLiteStorage) CreateEvent(event *models.Event) error {
+	return s.notifyAfter(event.CalendarID, s.withTx(func(tx *sql.Tx) error {
+		return insertEvent(tx, event)
+	}))
+}
+
+// withTx はfnを1トランザクションで実行する
+func (s *SQLiteStorage) withTx(fn func(tx *sql.Tx) error) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func insertEvent(db execer, event *models.Event) error {
+	reminders, err := formatReminders(event.Reminders)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Exec(
+		`INSERT INTO events (id, calendar_id, title, description, dtstart, dtend, rrule, exdates, rdates, timezone,
+			recurring_event_id, recurrence_id, related_to, uid, resource_name, reminders, all_day, created_at, updated_at)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		event.ID, event.CalendarID, event.Title, event.Description,
+		event.DTStart.UTC().Format(time.RFC3339), event.DTEnd.UTC().Format(time.RFC3339),
+		event.RRule, formatTimeList(event.ExDates), formatTimeList(event.RDates), event.Timezone,
+		event.RecurringEventID, formatOptionalTime(event.RecurrenceID), event.RelatedTo, event.UID, event.ResourceName,
+		reminders, event.AllDay, event.CreatedAt.Format(time.RFC3339), event.UpdatedAt.Format(time.RFC3339),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := recordChange(db, event, models.ChangeCreated); err != nil {
+		return err
+	}
+	return enqueueWebhooks(db, models.ChangeCreated, nil, event)
+}
+
+// GetEvent はイベントを取得
+func (s *SQLiteStorage) GetEvent(id string) (*models.Event, error) {
+	return scanEvent(s.db.QueryRow(`SELECT `+eventColumns+` FROM events WHERE id = ?`, id))
+}
+
+// GetEventByUID はUIDでイベントを取得
+func (s *SQLiteStorage) GetEventByUID(calendarID, uid string) (*models.Event, error) {
+	return scanEvent(s.db.QueryRow(
+		`SELECT `+eventColumns+` FROM events
+		 WHERE calendar_id = ? AND recurring_event_id = '' AND (uid = ? OR (uid = '' AND id = ?))
+		 ORDER BY uid DESC LIMIT 1`,
+		calendarID, uid, uid,
+	))
+}
+
+// GetEventByResourceName はCalDAVのリソース名でイベントを取得
+func (s *SQLiteStorage) GetEventByResourceName(calendarID, name string) (*models.Event, error) {
+	return scanEvent(s.db.QueryRow(
+		`SELECT `+eventColumns+` FROM events WHERE calendar_id = ? AND recurring_event_id = '' AND resource_name = ?`,
+		calendarID, name,
+	))
+}
+
 // ListEvents はイベント一覧を取得
-func (s *SQLiteStorage) ListEvents(calendarID string, start, end time.Time, limit, offset int) ([]*models.Event, error) {
-	rows, err := s.db.Query(
-		`SELECT id, calendar_id, title, description, dtstart, dtend, rrule, timezone, created_at, updated_at
-		 FROM events WHERE calendar_id = ? AND dtstart >= ? AND dtstart <= ? ORDER BY dtstart LIMIT ? OFFSET ?`,
-		calendarID, start.Format(time.RFC3339), end.Format(time.RFC3339), limit, offset,
+// 繰り返しイベントは期間より前に開始していても候補として返し、実際の判定は展開側で行う
+// オーバーライドは元の繰り返しイベントの展開時に置き換えるため含めない
+func (s *SQLiteStorage) ListEvents(calendarID string, start, end time.Time, after Cursor, limit int) ([]*models.Event, error) {
+	return s.queryEvents(
+		`SELECT `+eventColumns+`
+		 FROM events WHERE `+listEventsCondition+` AND (? OR dtstart > ? OR (dtstart = ? AND id > ?))
+		 ORDER BY dtstart, id LIMIT ?`,
+		calendarID, end.UTC().Format(time.RFC3339), start.UTC().Format(time.RFC3339),
+		after.IsZero(), after.Key, after.Key, after.ID, limit,
 	)
+}
+
+// CountEvents はListEventsの条件に一致するイベントの数を取得
+func (s *SQLiteStorage) CountEvents(calendarID string, start, end time.Time) (int, error) {
+	var n int
+	err := s.db.QueryRow(
+		`SELECT COUNT(*) FROM events WHERE `+listEventsCondition,
+		calendarID, end.UTC().Format(time.RFC3339), start.UTC().Format(time.RFC3339),
+	).Scan(&n)
+	return n, err
+}
+
+// listEventsCondition はListEventsとCountEventsで共通の絞り込み条件（calendar_id, end, startを順に渡す）
+const listEventsCondition = `calendar_id = ? AND recurring_event_id = '' AND (dtstart <= ? OR rdates != '') AND (rrule != '' OR rdates != '' OR dtend >= ?)`
+
+// ListCalendarEvents はカレンダー内のすべてのイベントを取得
+func (s *SQLiteStorage) ListCalendarEvents(calendarID string) ([]*models.Event, error) {
+	return s.queryEvents(`SELECT `+eventColumns+` FROM events WHERE calendar_id = ? ORDER BY dtstart, id`, calendarID)
+}
+
+// UpdateEvent はイベントを更新し、UpdatedAtを現在時刻にする
+func (s *SQLiteStorage) UpdateEvent(event *models.Event) error {
+	return s.notifyAfter(event.CalendarID, s.withTx(func(tx *sql.Tx) error {
+		return updateEvent(tx, event)
+	}))
+}
+
+func updateEvent(db execer, event *models.Event) error {
+	// Webhookで変更前の内容を送るため、更新の前に読み出しておく
+	before, err := scanEvent(db.QueryRow(`SELECT `+eventColumns+` FROM events WHERE id = ?`, event.ID))
+	if err != nil {
+		return err
+	}
+
+	reminders, err := formatReminders(event.Reminders)
+	if err != nil {
+		return err
+	}
+
+	event.UpdatedAt = time.Now()
+
+	res, err := db.Exec(
+		`UPDATE events SET title = ?, description = ?, dtstart = ?, dtend = ?, rrule = ?, exdates = ?, rdates = ?, timezone = ?,
+			related_to = ?, uid = ?, resource_name = ?, reminders = ?, all_day = ?, updated_at = ?
+		 WHERE id = ?`,
+		event.Title, event.Description,
+		event.DTStart.UTC().Format(time.RFC3339), event.DTEnd.UTC().Format(time.RFC3339),
+		event.RRule, formatTimeList(event.ExDates), formatTimeList(event.RDates), event.Timezone,
+		event.RelatedTo, event.UID, event.ResourceName, reminders, event.AllDay, event.UpdatedAt.Format(time.RFC3339),
+		event.ID,
+	)
+	if err != nil {
+		return err
+	}
+	if err := requireAffected(res); err != nil {
+		return err
+	}
+
+	if err := recordChange(db, event, models.ChangeUpdated); err != nil {
+		return err
+	}
+	return enqueueWebhooks(db, models.ChangeUpdated, before, event)
+}
+
+// SplitEvent は繰り返しイベントの分割を1トランザクションで行う
+// originalを打ち切った内容で更新し、nextを作成したうえで、movedのキー（元のオーバーライドのID）を
+// 値のオーバーライドに置き換える
+func (s *SQLiteStorage) SplitEvent(original, next *models.Event, moved map[string]*models.Event) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := updateEvent(tx, original); err != nil {
+		return err
+	}
+	if err := insertEvent(tx, next); err != nil {
+		return err
+	}
+	for oldID, override := range moved {
+		if _, err := tx.Exec(`DELETE FROM events WHERE id = ?`, oldID); err != nil {
+			return err
+		}
+		if err := insertEvent(tx, override); err != nil {
+			return err
+		}
+	}
+
+	return s.notifyAfter(original.CalendarID, tx.Commit())
+}
+
+// DeleteEvent はイベントを削除
+// 繰り返しイベントの場合はオーバーライドもまとめて削除する
+func (s *SQLiteStorage) DeleteEvent(id string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	event, err := scanEvent(tx.QueryRow(`SELECT `+eventColumns+` FROM events WHERE id = ?`, id))
+	if err != nil {
+		return err
+	}
+
+	if err := deleteTriggerState(tx, `id = ?`, id); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`DELETE FROM events WHERE id = ? OR recurring_event_id = ?`, id, id); err != nil {
+		return err
+	}
+
+	if event.IsOverride() {
+		// オーバーライドの削除は元の繰り返しイベントの変更として記録する
+		err = recordChange(tx, event, models.ChangeUpdated)
+	} else {
+		err = recordDeletion(tx, event)
+	}
+	if err != nil {
+		return err
+	}
+	if err := enqueueWebhooks(tx, models.ChangeDeleted, event, nil); err != nil {
+		return err
+	}
+
+	return s.notifyAfter(event.CalendarID, tx.Commit())
+}
+
+// ListOverrides は繰り返しイベントのオーバーライドを取得
+func (s *SQLiteStorage) ListOverrides(recurringEventID string) ([]*models.Event, error) {
+	return s.queryEvents(`SELECT `+eventColumns+` FROM events WHERE recurring_event_id = ? ORDER BY recurrence_id`, recurringEventID)
+}
+
+// ListCalendarOverrides はカレンダー内のすべてのオーバーライドを取得
+func (s *SQLiteStorage) ListCalendarOverrides(calendarID string) ([]*models.Event, error) {
+	return s.queryEvents(`SELECT `+eventColumns+` FROM events WHERE calendar_id = ? AND recurring_event_id != '' ORDER BY recurrence_id`, calendarID)
+}
+
+// queryEvents はeventColumnsを選択するクエリを実行してイベントのリストを返す
+func (s *SQLiteStorage) queryEvents(query string, args ...any) ([]*models.Event, error) {
+	rows, err := s.db.Query(query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -196,21 +900,24 @@ func (s *SQLiteStorage) ListEvents(calendarID string, start, end time.Time, limi
 
 	var events []*models.Event
 	for rows.Next() {
-		var event models.Event
-		var dtStart, dtEnd, createdAt, updatedAt string
-
-		if err := rows.Scan(&event.ID, &event.CalendarID, &event.Title, &event.Description,
-			&dtStart, &dtEnd, &event.RRule, &event.Timezone, &createdAt, &updatedAt); err != nil {
+		event, err := scanEvent(rows)
+		if err != nil {
 			return nil, err
 		}
-
-		event.DTStart, _ = time.Parse(time.RFC3339, dtStart)
-		event.DTEnd, _ = time.Parse(time.RFC3339, dtEnd)
-		event.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
-		event.UpdatedAt, _ = time.Parse(time.RFC3339, updatedAt)
-
-		events = append(events, &event)
+		events = append(events, event)
 	}
 
 	return events, rows.Err()
 }
+
+// requireAffected は更新対象の行が存在しない場合にsql.ErrNoRowsを返す
+func requireAffected(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package storage
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/recurrence-scheduler/internal/models"
+)
+
+func TestMigrateNormalizesEventTimesToUTC(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.db")
+	s, err := NewSQLiteStorage(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cal := models.NewCalendar("test", "", "Asia/Tokyo")
+	if err := s.CreateCalendar(cal); err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	event := models.NewEvent(cal.ID, "event", "", start, start.Add(time.Hour), "", "Asia/Tokyo")
+	if err := s.CreateEvent(event); err != nil {
+		t.Fatal(err)
+	}
+	// 以前のバージョンはオフセット付きで保存していた
+	if _, err := s.db.Exec(`UPDATE events SET dtstart = ?, dtend = ? WHERE id = ?`,
+		"2025-01-06T18:00:00+09:00", "2025-01-06T19:00:00+09:00", event.ID); err != nil {
+		t.Fatal(err)
+	}
+	s.Close()
+
+	s, err = NewSQLiteStorage(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	var dtStart, dtEnd string
+	if err := s.db.QueryRow(`SELECT dtstart, dtend FROM events WHERE id = ?`, event.ID).Scan(&dtStart, &dtEnd); err != nil {
+		t.Fatal(err)
+	}
+	if dtStart != "2025-01-06T09:00:00Z" || dtEnd != "2025-01-06T10:00:00Z" {
+		t.Errorf("dtstart, dtend = %s, %s; want UTC", dtStart, dtEnd)
+	}
+
+	// UTCにそろえた後は文字列比較の範囲検索で見つかる
+	events, err := s.ListEvents(cal.ID, start.Add(-time.Minute), start.Add(time.Minute), Cursor{}, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 {
+		t.Errorf("ListEvents returned %d events, want 1", len(events))
+	}
+}
+
+func TestCalendarTimesInUTC(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.db")
+	s, err := NewSQLiteStorage(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tokyo := time.FixedZone("JST", 9*60*60)
+	base := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	// 作成順: old（UTC） → mid（+09:00で保存） → recent（+09:00の時刻で作成）
+	old := models.NewCalendar("old", "", "UTC")
+	old.CreatedAt = base
+	mid := models.NewCalendar("mid", "", "UTC")
+	mid.CreatedAt = base.Add(time.Hour)
+	recent := models.NewCalendar("recent", "", "UTC")
+	recent.CreatedAt = base.Add(2 * time.Hour).In(tokyo)
+	for _, cal := range []*models.Calendar{old, mid, recent} {
+		if err := s.CreateCalendar(cal); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// 以前のバージョンはオフセット付きで保存していた
+	if _, err := s.db.Exec(`UPDATE calendars SET created_at = ?, updated_at = ? WHERE id = ?`,
+		"2025-01-06T19:00:00+09:00", "2025-01-06T19:00:00+09:00", mid.ID); err != nil {
+		t.Fatal(err)
+	}
+	s.Close()
+
+	s, err = NewSQLiteStorage(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	rows, err := s.db.Query(`SELECT created_at, updated_at FROM calendars`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for rows.Next() {
+		var createdAt, updatedAt string
+		if err := rows.Scan(&createdAt, &updatedAt); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasSuffix(createdAt, "Z") || !strings.HasSuffix(updatedAt, "Z") {
+			t.Errorf("created_at, updated_at = %s, %s; want UTC", createdAt, updatedAt)
+		}
+	}
+	rows.Close()
+
+	// 1件ずつページを進めても作成日時の新しい順に並ぶ
+	var got []string
+	after := Cursor{}
+	for {
+		page, err := s.ListCalendars(after, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(page) == 0 {
+			break
+		}
+		got = append(got, page[0].Name)
+		after = CalendarCursor(page[0])
+	}
+	if strings.Join(got, ",") != "recent,mid,old" {
+		t.Errorf("ListCalendars pages = %v, want [recent mid old]", got)
+	}
+}
@@ -0,0 +1,183 @@
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/recurrence-scheduler/internal/models"
+)
+
+// RecordFiring はインスタンスの開始またはリマインダーの発火を記録し、発火済みの時刻（high-water mark）を進める
+// 発火済みの時刻はインスタンスの開始はイベントごと、リマインダーはイベントとリマインダーの組ごとに持つ
+// 同じIDの発火が既に記録されている場合は記録せずfalseを返す（時刻は進める）
+func (s *SQLiteStorage) RecordFiring(firing *models.Firing, firedThrough time.Time) (bool, error) {
+	instance, err := json.Marshal(firing.Instance)
+	if err != nil {
+		return false, err
+	}
+	var reminder []byte
+	if firing.Reminder != nil {
+		if reminder, err = json.Marshal(firing.Reminder); err != nil {
+			return false, err
+		}
+	}
+
+	var inserted bool
+	err = s.withTx(func(tx *sql.Tx) error {
+		res, err := tx.Exec(
+			`INSERT OR IGNORE INTO occurrence_firings (id, event_id, calendar_id, instance, reminder, status, fired_at) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			firing.ID, firing.EventID, firing.CalendarID, string(instance), string(reminder), models.FiringPending,
+			firing.FiredAt.UTC().Format(time.RFC3339),
+		)
+		if err != nil {
+			return err
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		inserted = n > 0
+
+		// 時刻はUTCのRFC3339で保存するため、文字列の比較で新しい方を残せる
+		if firing.Reminder != nil {
+			_, err = tx.Exec(
+				`INSERT INTO reminder_marks (event_id, reminder_id, fired_through) VALUES (?, ?, ?)
+				 ON CONFLICT(event_id, reminder_id) DO UPDATE SET fired_through = MAX(fired_through, excluded.fired_through)`,
+				firing.EventID, firing.Reminder.ID, firedThrough.UTC().Format(time.RFC3339),
+			)
+			return err
+		}
+		_, err = tx.Exec(
+			`INSERT INTO trigger_marks (event_id, fired_through) VALUES (?, ?)
+			 ON CONFLICT(event_id) DO UPDATE SET fired_through = MAX(fired_through, excluded.fired_through)`,
+			firing.EventID, firedThrough.UTC().Format(time.RFC3339),
+		)
+		return err
+	})
+	return inserted, err
+}
+
+// CompleteFiring は発火に対するアクションをすべて実行したことを記録
+func (s *SQLiteStorage) CompleteFiring(id string) error {
+	res, err := s.db.Exec(`UPDATE occurrence_firings SET status = ? WHERE id = ?`, models.FiringDone, id)
+	if err != nil {
+		return err
+	}
+	return requireAffected(res)
+}
+
+// ListPendingFirings はアクションの実行が終わっていない発火を記録順に取得
+func (s *SQLiteStorage) ListPendingFirings() ([]*models.Firing, error) {
+	rows, err := s.db.Query(
+		`SELECT id, event_id, calendar_id, instance, reminder, status, fired_at FROM occurrence_firings WHERE status = ? ORDER BY fired_at, id`,
+		models.FiringPending,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var firings []*models.Firing
+	for rows.Next() {
+		var firing models.Firing
+		var instance, reminder, firedAt string
+		if err := rows.Scan(&firing.ID, &firing.EventID, &firing.CalendarID, &instance, &reminder, &firing.Status, &firedAt); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(instance), &firing.Instance); err != nil {
+			return nil, err
+		}
+		if reminder != "" {
+			if err := json.Unmarshal([]byte(reminder), &firing.Reminder); err != nil {
+				return nil, err
+			}
+		}
+		firing.FiredAt, _ = time.Parse(time.RFC3339, firedAt)
+		firings = append(firings, &firing)
+	}
+
+	return firings, rows.Err()
+}
+
+// GetTriggerMark はイベントの発火済みの時刻を取得（まだ発火していなければゼロ値）
+func (s *SQLiteStorage) GetTriggerMark(eventID string) (time.Time, error) {
+	return s.queryMark(`SELECT fired_through FROM trigger_marks WHERE event_id = ?`, eventID)
+}
+
+// GetReminderMark はイベントのリマインダーの発火済みの時刻を取得（まだ発火していなければゼロ値）
+func (s *SQLiteStorage) GetReminderMark(eventID, reminderID string) (time.Time, error) {
+	return s.queryMark(`SELECT fired_through FROM reminder_marks WHERE event_id = ? AND reminder_id = ?`, eventID, reminderID)
+}
+
+// queryMark は発火済みの時刻を1件読み出す（行がなければゼロ値）
+func (s *SQLiteStorage) queryMark(query string, args ...any) (time.Time, error) {
+	var firedThrough string
+	err := s.db.QueryRow(query, args...).Scan(&firedThrough)
+	if err == sql.ErrNoRows {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Parse(time.RFC3339, firedThrough)
+}
+
+// deleteTriggerState はwhereに一致するイベントの発火済みの時刻（リマインダーを含む）を削除する
+func deleteTriggerState(db execer, where string, args ...any) error {
+	for _, table := range []string{"trigger_marks", "reminder_marks"} {
+		if _, err := db.Exec(`DELETE FROM `+table+` WHERE event_id IN (SELECT id FROM events WHERE `+where+`)`, args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EnqueueOccurrenceWebhooks はインスタンスの開始（リマインダーの場合はリマインダー）を通知の対象にしているWebhookごとに配信を登録する
+// 配信のIDはWebhookと発火のIDから決めるため、同じ発火に対して何度呼び出しても配信は1件になる
+func (s *SQLiteStorage) EnqueueOccurrenceWebhooks(firing *models.Firing) error {
+	changeType := firing.Type()
+
+	return s.withTx(func(tx *sql.Tx) error {
+		webhooks, err := listWebhooks(tx, firing.CalendarID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		for _, webhook := range webhooks {
+			if !webhook.Accepts(changeType) {
+				continue
+			}
+
+			id := uuid.NewSHA1(uuid.NameSpaceURL, []byte(webhook.ID+"/"+firing.ID)).String()
+			payload, err := json.Marshal(&models.WebhookPayload{
+				ID:         id,
+				Type:       changeType.EventName(),
+				WebhookID:  webhook.ID,
+				CalendarID: firing.CalendarID,
+				EventID:    firing.EventID,
+				OccurredAt: firing.FiredAt.UTC(),
+				Occurrence: firing.Instance,
+				Reminder:   firing.Reminder,
+			})
+			if err != nil {
+				return err
+			}
+
+			if _, err := tx.Exec(
+				`INSERT OR IGNORE INTO webhook_deliveries (id, webhook_id, calendar_id, event_id, change_type, payload, status, attempts,
+					next_attempt_at, last_error, created_at, updated_at)
+				 VALUES (?, ?, ?, ?, ?, ?, ?, 0, ?, '', ?, ?)`,
+				id, webhook.ID, firing.CalendarID, firing.EventID, changeType, string(payload), models.DeliveryPending,
+				now.Format(time.RFC3339), now.Format(time.RFC3339), now.Format(time.RFC3339),
+			); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,58 @@
+package storage
+
+import "sync"
+
+// watchers はカレンダーごとの変更の購読者
+// 通知は「変更があった」ことだけを伝え、内容は購読者がListChangesで読み出す
+type watchers struct {
+	mu   sync.Mutex
+	subs map[string]map[chan struct{}]bool
+}
+
+// Watch はカレンダーに変更が記録されるたびに通知を受け取るチャネルを返す
+// 通知は取りこぼしを防ぐため1件までバッファし、まとめて届くことがある。stopで購読をやめる
+// calendarIDが空の場合はすべてのカレンダーの変更を通知する
+func (s *SQLiteStorage) Watch(calendarID string) (notify <-chan struct{}, stop func()) {
+	ch := make(chan struct{}, 1)
+
+	s.watchers.mu.Lock()
+	if s.watchers.subs == nil {
+		s.watchers.subs = make(map[string]map[chan struct{}]bool)
+	}
+	if s.watchers.subs[calendarID] == nil {
+		s.watchers.subs[calendarID] = make(map[chan struct{}]bool)
+	}
+	s.watchers.subs[calendarID][ch] = true
+	s.watchers.mu.Unlock()
+
+	return ch, func() {
+		s.watchers.mu.Lock()
+		defer s.watchers.mu.Unlock()
+		delete(s.watchers.subs[calendarID], ch)
+		if len(s.watchers.subs[calendarID]) == 0 {
+			delete(s.watchers.subs, calendarID)
+		}
+	}
+}
+
+// notify はカレンダーの購読者と、すべてのカレンダーの購読者に変更を通知する
+func (w *watchers) notify(calendarID string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, subs := range []map[chan struct{}]bool{w.subs[calendarID], w.subs[""]} {
+		for ch := range subs {
+			select {
+			case ch <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// notifyAfter は書き込みが成功した場合にカレンダーの購読者へ通知し、errをそのまま返す
+func (s *SQLiteStorage) notifyAfter(calendarID string, err error) error {
+	if err == nil {
+		s.watchers.notify(calendarID)
+	}
+	return err
+}
@@ -0,0 +1,286 @@
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/recurrence-scheduler/internal/models"
+)
+
+// webhookColumns はwebhooksテーブルから読み出すカラム
+const webhookColumns = `id, calendar_id, url, secret, event_types, created_at`
+
+// deliveryColumns はwebhook_deliveriesテーブルから読み出すカラム
+const deliveryColumns = `id, webhook_id, calendar_id, event_id, change_type, payload, status, attempts,
+	next_attempt_at, last_error, created_at, updated_at`
+
+// DeliveryCursor は配信の直後から続けるためのカーソルを返す（配信は新しい順に並べる）
+func DeliveryCursor(delivery *models.WebhookDelivery) Cursor {
+	return Cursor{Key: delivery.CreatedAt.UTC().Format(time.RFC3339), ID: delivery.ID}
+}
+
+// CreateWebhook はWebhookを登録
+func (s *SQLiteStorage) CreateWebhook(webhook *models.Webhook) error {
+	types := make([]string, len(webhook.EventTypes))
+	for i, t := range webhook.EventTypes {
+		types[i] = string(t)
+	}
+
+	_, err := s.db.Exec(
+		`INSERT INTO webhooks (id, calendar_id, url, secret, event_types, created_at) VALUES (?, ?, ?, ?, ?, ?)`,
+		webhook.ID, webhook.CalendarID, webhook.URL, webhook.Secret, strings.Join(types, ","),
+		webhook.CreatedAt.UTC().Format(time.RFC3339),
+	)
+	return err
+}
+
+// scanWebhook はwebhookColumnsの順で1行を読み出す
+func scanWebhook(row rowScanner) (*models.Webhook, error) {
+	var webhook models.Webhook
+	var eventTypes, createdAt string
+
+	if err := row.Scan(&webhook.ID, &webhook.CalendarID, &webhook.URL, &webhook.Secret, &eventTypes, &createdAt); err != nil {
+		return nil, err
+	}
+
+	if eventTypes != "" {
+		for _, t := range strings.Split(eventTypes, ",") {
+			webhook.EventTypes = append(webhook.EventTypes, models.ChangeType(t))
+		}
+	}
+	webhook.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
+
+	return &webhook, nil
+}
+
+// GetWebhook はWebhookを取得
+func (s *SQLiteStorage) GetWebhook(id string) (*models.Webhook, error) {
+	return scanWebhook(s.db.QueryRow(`SELECT `+webhookColumns+` FROM webhooks WHERE id = ?`, id))
+}
+
+// ListWebhooks はカレンダーのWebhookを登録順に取得
+func (s *SQLiteStorage) ListWebhooks(calendarID string) ([]*models.Webhook, error) {
+	return listWebhooks(s.db, calendarID)
+}
+
+func listWebhooks(db execer, calendarID string) ([]*models.Webhook, error) {
+	rows, err := db.Query(`SELECT `+webhookColumns+` FROM webhooks WHERE calendar_id = ? ORDER BY created_at, id`, calendarID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var webhooks []*models.Webhook
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, rows.Err()
+}
+
+// DeleteWebhook はWebhookを、未送信の配信と配信ログを含めて削除
+func (s *SQLiteStorage) DeleteWebhook(id string) error {
+	return s.withTx(func(tx *sql.Tx) error {
+		if err := deleteDeliveries(tx, `webhook_id = ?`, id); err != nil {
+			return err
+		}
+		res, err := tx.Exec(`DELETE FROM webhooks WHERE id = ?`, id)
+		if err != nil {
+			return err
+		}
+		return requireAffected(res)
+	})
+}
+
+// deleteDeliveries はwhereに一致する配信とその配信ログを削除する
+func deleteDeliveries(db execer, where string, args ...any) error {
+	if _, err := db.Exec(`DELETE FROM webhook_attempts WHERE delivery_id IN (SELECT id FROM webhook_deliveries WHERE `+where+`)`, args...); err != nil {
+		return err
+	}
+	_, err := db.Exec(`DELETE FROM webhook_deliveries WHERE `+where, args...)
+	return err
+}
+
+// enqueueWebhooks はイベントの変更を通知の対象にしているWebhookごとに配信を登録する
+// 変更と同じトランザクションで登録するため、書き込みが成功した変更は必ず配信される
+// オーバーライドの変更は変更履歴と同じく元の繰り返しイベントの更新として扱う
+func enqueueWebhooks(db execer, changeType models.ChangeType, before, after *models.Event) error {
+	event := after
+	if event == nil {
+		event = before
+	}
+	eventID := event.ID
+	if event.IsOverride() {
+		eventID = event.RecurringEventID
+		changeType = models.ChangeUpdated
+	}
+
+	webhooks, err := listWebhooks(db, event.CalendarID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	for _, webhook := range webhooks {
+		if !webhook.Accepts(changeType) {
+			continue
+		}
+
+		id := uuid.New().String()
+		payload, err := json.Marshal(&models.WebhookPayload{
+			ID:         id,
+			Type:       changeType.EventName(),
+			WebhookID:  webhook.ID,
+			CalendarID: event.CalendarID,
+			EventID:    eventID,
+			OccurredAt: now,
+			Before:     before,
+			After:      after,
+		})
+		if err != nil {
+			return err
+		}
+
+		if _, err := db.Exec(
+			`INSERT INTO webhook_deliveries (id, webhook_id, calendar_id, event_id, change_type, payload, status, attempts,
+				next_attempt_at, last_error, created_at, updated_at)
+			 VALUES (?, ?, ?, ?, ?, ?, ?, 0, ?, '', ?, ?)`,
+			id, webhook.ID, event.CalendarID, eventID, changeType, string(payload), models.DeliveryPending,
+			now.Format(time.RFC3339), now.Format(time.RFC3339), now.Format(time.RFC3339),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scanDelivery はdeliveryColumnsの順で1行を読み出す
+func scanDelivery(row rowScanner) (*models.WebhookDelivery, error) {
+	var delivery models.WebhookDelivery
+	var payload, nextAttemptAt, createdAt, updatedAt string
+
+	if err := row.Scan(&delivery.ID, &delivery.WebhookID, &delivery.CalendarID, &delivery.EventID, &delivery.Type,
+		&payload, &delivery.Status, &delivery.Attempts, &nextAttemptAt, &delivery.LastError, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	delivery.Payload = []byte(payload)
+	delivery.NextAttemptAt, _ = time.Parse(time.RFC3339, nextAttemptAt)
+	delivery.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
+	delivery.UpdatedAt, _ = time.Parse(time.RFC3339, updatedAt)
+
+	return &delivery, nil
+}
+
+// queryDeliveries はdeliveryColumnsを選択するクエリを実行して配信のリストを返す
+func (s *SQLiteStorage) queryDeliveries(query string, args ...any) ([]*models.WebhookDelivery, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []*models.WebhookDelivery
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, rows.Err()
+}
+
+// ListDueDeliveries は送信時刻がnow以前の未送信の配信を送信時刻順に取得
+func (s *SQLiteStorage) ListDueDeliveries(now time.Time, limit int) ([]*models.WebhookDelivery, error) {
+	return s.queryDeliveries(
+		`SELECT `+deliveryColumns+` FROM webhook_deliveries
+		 WHERE status = ? AND next_attempt_at <= ? ORDER BY next_attempt_at, id LIMIT ?`,
+		models.DeliveryPending, now.UTC().Format(time.RFC3339), limit,
+	)
+}
+
+// ListDeliveries はWebhookの配信を新しい順に取得（statusが空の場合はすべての状態）
+func (s *SQLiteStorage) ListDeliveries(webhookID string, status models.DeliveryStatus, after Cursor, limit int) ([]*models.WebhookDelivery, error) {
+	return s.queryDeliveries(
+		`SELECT `+deliveryColumns+` FROM webhook_deliveries
+		 WHERE webhook_id = ? AND (? = '' OR status = ?) AND `+deliveriesAfter+`
+		 ORDER BY created_at DESC, id DESC LIMIT ?`,
+		webhookID, status, status, after.IsZero(), after.Key, after.Key, after.ID, limit,
+	)
+}
+
+// ListDeadLetters はカレンダー内のすべてのWebhookのデッドレターを新しい順に取得
+func (s *SQLiteStorage) ListDeadLetters(calendarID string, after Cursor, limit int) ([]*models.WebhookDelivery, error) {
+	return s.queryDeliveries(
+		`SELECT `+deliveryColumns+` FROM webhook_deliveries
+		 WHERE calendar_id = ? AND status = ? AND `+deliveriesAfter+`
+		 ORDER BY created_at DESC, id DESC LIMIT ?`,
+		calendarID, models.DeliveryDead, after.IsZero(), after.Key, after.Key, after.ID, limit,
+	)
+}
+
+// deliveriesAfter は新しい順の配信でカーソルより後を選ぶ条件（カーソルの先頭判定、Key、Key、IDを順に渡す）
+const deliveriesAfter = `(? OR created_at < ? OR (created_at = ? AND id < ?))`
+
+// RecordAttempt は送信結果を配信ログに追加し、配信の状態・送信回数・次の送信時刻を更新する
+func (s *SQLiteStorage) RecordAttempt(delivery *models.WebhookDelivery, attempt *models.WebhookAttempt) error {
+	delivery.UpdatedAt = time.Now()
+
+	return s.withTx(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(
+			`INSERT INTO webhook_attempts (delivery_id, attempted_at, status_code, error, duration_ms) VALUES (?, ?, ?, ?, ?)`,
+			delivery.ID, attempt.AttemptedAt.UTC().Format(time.RFC3339), attempt.StatusCode, attempt.Error,
+			attempt.Duration.Milliseconds(),
+		); err != nil {
+			return err
+		}
+
+		res, err := tx.Exec(
+			`UPDATE webhook_deliveries SET status = ?, attempts = ?, next_attempt_at = ?, last_error = ?, updated_at = ? WHERE id = ?`,
+			delivery.Status, delivery.Attempts, delivery.NextAttemptAt.UTC().Format(time.RFC3339), delivery.LastError,
+			delivery.UpdatedAt.UTC().Format(time.RFC3339), delivery.ID,
+		)
+		if err != nil {
+			return err
+		}
+		return requireAffected(res)
+	})
+}
+
+// ListAttempts は配信の送信結果を送信順に取得
+func (s *SQLiteStorage) ListAttempts(deliveryID string) ([]*models.WebhookAttempt, error) {
+	rows, err := s.db.Query(
+		`SELECT delivery_id, attempted_at, status_code, error, duration_ms FROM webhook_attempts WHERE delivery_id = ? ORDER BY id`,
+		deliveryID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var attempts []*models.WebhookAttempt
+	for rows.Next() {
+		var attempt models.WebhookAttempt
+		var attemptedAt string
+		var durationMS int64
+		if err := rows.Scan(&attempt.DeliveryID, &attemptedAt, &attempt.StatusCode, &attempt.Error, &durationMS); err != nil {
+			return nil, err
+		}
+		attempt.AttemptedAt, _ = time.Parse(time.RFC3339, attemptedAt)
+		attempt.Duration = time.Duration(durationMS) * time.Millisecond
+		attempts = append(attempts, &attempt)
+	}
+
+	return attempts, rows.Err()
+}
@@ -0,0 +1,94 @@
+package trigger
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/recurrence-scheduler/internal/models"
+	"github.com/recurrence-scheduler/internal/storage"
+)
+
+// LogAction はインスタンスの開始をログに出力する（リマインダーはStdoutNotifierで出力する）
+type LogAction struct{}
+
+// Fire はActionの実装
+func (LogAction) Fire(ctx context.Context, firing *models.Firing) error {
+	if firing.Reminder != nil {
+		return nil
+	}
+	log.Printf("trigger: %q (event %s) started at %s", firing.Instance.Title, firing.EventID,
+		firing.Instance.DTStart.UTC().Format(time.RFC3339))
+	return nil
+}
+
+// WebhookAction はインスタンスの開始とリマインダーを、occurrence_started・reminder_dueを指定したWebhookへの配信として登録する
+// 送信と再送はwebhook.Dispatcherが行う
+type WebhookAction struct {
+	storage storage.Storage
+}
+
+// NewWebhookAction は新しいWebhookActionを作成する
+func NewWebhookAction(st storage.Storage) *WebhookAction {
+	return &WebhookAction{storage: st}
+}
+
+// Fire はActionの実装
+func (a *WebhookAction) Fire(ctx context.Context, firing *models.Firing) error {
+	return a.storage.EnqueueOccurrenceWebhooks(firing)
+}
+
+// subscriberBuffer は購読者ごとにバッファする開始の数
+const subscriberBuffer = 16
+
+// Broadcaster はインスタンスの開始を購読者（WatchOccurrencesのストリームなど）に配る（リマインダーは配らない）
+// 購読していない間の開始は届かず、受信が追いつかない購読者には一部の開始が届かないことがある
+type Broadcaster struct {
+	mu   sync.Mutex
+	subs map[string]map[chan *models.Firing]bool
+}
+
+// NewBroadcaster は新しいBroadcasterを作成する
+func NewBroadcaster() *Broadcaster {
+	return &Broadcaster{subs: make(map[string]map[chan *models.Firing]bool)}
+}
+
+// Subscribe はカレンダーのインスタンスの開始を受け取るチャネルを返す（stopで購読をやめる）
+func (b *Broadcaster) Subscribe(calendarID string) (firings <-chan *models.Firing, stop func()) {
+	ch := make(chan *models.Firing, subscriberBuffer)
+
+	b.mu.Lock()
+	if b.subs[calendarID] == nil {
+		b.subs[calendarID] = make(map[chan *models.Firing]bool)
+	}
+	b.subs[calendarID][ch] = true
+	b.mu.Unlock()
+
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subs[calendarID], ch)
+		if len(b.subs[calendarID]) == 0 {
+			delete(b.subs, calendarID)
+		}
+	}
+}
+
+// Fire はActionの実装
+func (b *Broadcaster) Fire(ctx context.Context, firing *models.Firing) error {
+	if firing.Reminder != nil {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subs[firing.CalendarID] {
+		select {
+		case ch <- firing:
+		default:
+			log.Printf("trigger: dropped firing %s for a slow subscriber", firing.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,354 @@
+package trigger
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/recurrence-scheduler/internal/models"
+	"github.com/recurrence-scheduler/internal/recurrence"
+	"github.com/recurrence-scheduler/internal/storage"
+)
+
+const (
+	// defaultCatchUp は停止中に開始時刻を過ぎたインスタンスをさかのぼって発火する期間の既定値
+	defaultCatchUp = 24 * time.Hour
+	// retryDelay は発火を記録できなかった場合に再試行するまでの時間
+	retryDelay = 10 * time.Second
+	// maxWait は次の開始時刻までの待ち時間の上限（システム時刻の変更に追従するため）
+	maxWait = time.Minute
+	// maxReminderScan はリマインダーの次の発火を探すときに調べるインスタンスの上限
+	maxReminderScan = 1000
+)
+
+// Action はインスタンスの開始時とリマインダーの発火時に実行する処理
+// リマインダーの発火ではfiring.Reminderが設定される
+type Action interface {
+	Fire(ctx context.Context, firing *models.Firing) error
+}
+
+// Engine はすべてのイベントの次のインスタンスとリマインダーの次の発火をヒープで管理し、時刻になったらアクションを実行する
+//
+// イベントごと（リマインダーはイベントとリマインダーの組ごと）に発火済みの時刻（high-water mark）をSQLiteに保存し、
+// その後の発火だけを行う。発火の記録はインスタンスのIDを主キーにするため、再起動しても同じインスタンスを二度発火しない。
+// 停止中に時刻を過ぎた発火は、CatchUpの範囲内であれば再起動後に順に行う。
+type Engine struct {
+	storage storage.Storage
+	actions []Action
+
+	// CatchUp はさかのぼって発火する期間。これより前に開始したインスタンスは発火しない
+	CatchUp time.Duration
+
+	queue *timerQueue
+	// seqs はカレンダーごとに読み込み済みの変更番号
+	seqs map[string]int64
+	now  func() time.Time
+}
+
+// NewEngine は新しいEngineを作成する
+func NewEngine(st storage.Storage, actions ...Action) *Engine {
+	return &Engine{
+		storage: st,
+		actions: actions,
+		CatchUp: defaultCatchUp,
+		queue:   newTimerQueue(),
+		seqs:    make(map[string]int64),
+		now:     time.Now,
+	}
+}
+
+// Run はctxが終了するまでインスタンスの開始を待ち、アクションを実行し続ける
+func (e *Engine) Run(ctx context.Context) {
+	// 読み込みの途中で記録された変更を取りこぼさないよう、先に購読する
+	notify, stop := e.storage.Watch("")
+	defer stop()
+
+	e.recover(ctx)
+	e.load()
+
+	for {
+		e.fireDue(ctx)
+
+		wait := maxWait
+		if next := e.queue.peek(); next != nil {
+			wait = min(next.at.Sub(e.now()), maxWait)
+		}
+		timer := time.NewTimer(wait)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-notify:
+			e.sync()
+		case <-timer.C:
+		}
+		timer.Stop()
+	}
+}
+
+// recover は前回の停止でアクションの実行が終わらなかった開始のアクションを実行する
+func (e *Engine) recover(ctx context.Context) {
+	firings, err := e.storage.ListPendingFirings()
+	if err != nil {
+		log.Printf("trigger: failed to list pending firings: %v", err)
+		return
+	}
+	for _, firing := range firings {
+		e.runActions(ctx, firing)
+	}
+}
+
+// load はすべてのカレンダーのイベントを読み込み、次のインスタンスをヒープに積む
+func (e *Engine) load() {
+	calendars, err := e.storage.ListCalendars(storage.Cursor{}, -1)
+	if err != nil {
+		log.Printf("trigger: failed to list calendars: %v", err)
+		return
+	}
+
+	for _, cal := range calendars {
+		// 以降の変更はsyncで反映する
+		seq, err := e.storage.LatestChange(cal.ID)
+		if err != nil {
+			log.Printf("trigger: failed to read changes of calendar %s: %v", cal.ID, err)
+			continue
+		}
+		e.seqs[cal.ID] = seq
+
+		events, err := e.storage.ListCalendarEvents(cal.ID)
+		if err != nil {
+			log.Printf("trigger: failed to list events of calendar %s: %v", cal.ID, err)
+			continue
+		}
+
+		overrides := make(map[string][]*models.Event)
+		for _, event := range events {
+			if event.IsOverride() {
+				overrides[event.RecurringEventID] = append(overrides[event.RecurringEventID], event)
+			}
+		}
+		for _, event := range events {
+			if !event.IsOverride() {
+				e.schedule(event, overrides[event.ID])
+			}
+		}
+	}
+}
+
+// sync は前回から記録された変更を読み、変更されたイベントの次のインスタンスを求め直す
+func (e *Engine) sync() {
+	calendars, err := e.storage.ListCalendars(storage.Cursor{}, -1)
+	if err != nil {
+		log.Printf("trigger: failed to list calendars: %v", err)
+		return
+	}
+
+	live := make(map[string]bool, len(calendars))
+	for _, cal := range calendars {
+		live[cal.ID] = true
+
+		changes, err := e.storage.ListChanges(cal.ID, e.seqs[cal.ID])
+		if err != nil {
+			log.Printf("trigger: failed to read changes of calendar %s: %v", cal.ID, err)
+			continue
+		}
+		changed := make(map[string]bool)
+		for _, change := range changes {
+			changed[change.EventID] = true
+			e.seqs[cal.ID] = change.Seq
+		}
+		for eventID := range changed {
+			e.reschedule(eventID)
+		}
+	}
+
+	// 削除されたカレンダーのイベントは変更履歴ごと消えるため、ここで取り除く
+	for calendarID := range e.seqs {
+		if !live[calendarID] {
+			delete(e.seqs, calendarID)
+		}
+	}
+	for _, entry := range e.queue.byKey {
+		if !live[entry.instance.CalendarID] {
+			e.queue.remove(entry)
+		}
+	}
+}
+
+// reschedule はイベントを読み直して次のインスタンスとリマインダーの発火をヒープに積む（削除されていれば取り除く）
+func (e *Engine) reschedule(eventID string) {
+	e.queue.removeEvent(eventID)
+
+	event, err := e.storage.GetEvent(eventID)
+	if err != nil {
+		return
+	}
+	overrides, err := e.storage.ListOverrides(eventID)
+	if err != nil {
+		log.Printf("trigger: failed to list overrides of event %s: %v", eventID, err)
+		return
+	}
+	e.schedule(event, overrides)
+}
+
+// schedule は発火済みの時刻より後の最初のインスタンスと、リマインダーごとの最初の発火をヒープに積む
+// まだ発火していないイベントは作成日時より後のインスタンスから発火する
+func (e *Engine) schedule(event *models.Event, overrides []*models.Event) {
+	after, err := e.storage.GetTriggerMark(event.ID)
+	if err != nil {
+		log.Printf("trigger: failed to read the high-water mark of event %s: %v", event.ID, err)
+		return
+	}
+	if after.IsZero() {
+		after = event.CreatedAt
+	}
+
+	next, err := recurrence.Next(event, overrides, e.clamp(after))
+	if err != nil {
+		log.Printf("trigger: failed to compute the next occurrence of event %s: %v", event.ID, err)
+		next = nil
+	}
+	if next != nil {
+		e.queue.set(event.ID, nil, next, next.DTStart)
+	}
+
+	for i := range event.Reminders {
+		e.scheduleReminder(event, overrides, event, &event.Reminders[i])
+	}
+	// オーバーライドだけにあるリマインダーはそのインスタンスに対してのみ発火する
+	for _, override := range overrides {
+		for i := range override.Reminders {
+			if event.FindReminder(override.Reminders[i].ID) == nil {
+				e.scheduleReminder(event, overrides, override, &override.Reminders[i])
+			}
+		}
+	}
+}
+
+// scheduleReminder はリマインダーの発火済みの時刻より後の最初の発火をヒープに積む
+// ownerはリマインダーを定義したイベント（繰り返しの親イベントまたはオーバーライド）
+// まだ発火していないリマインダーは、追加される前の時刻にさかのぼって発火しないようownerの更新日時より後から発火する
+func (e *Engine) scheduleReminder(event *models.Event, overrides []*models.Event, owner *models.Event, reminder *models.Reminder) {
+	after, err := e.storage.GetReminderMark(event.ID, reminder.ID)
+	if err != nil {
+		log.Printf("trigger: failed to read the high-water mark of reminder %s: %v", reminder.ID, err)
+		return
+	}
+	if after.IsZero() {
+		after = owner.UpdatedAt
+	}
+
+	instance, effective, at, err := nextReminder(event, overrides, owner, reminder, e.clamp(after))
+	if err != nil {
+		log.Printf("trigger: failed to compute the next reminder %s of event %s: %v", reminder.ID, event.ID, err)
+		return
+	}
+	if instance != nil {
+		e.queue.set(event.ID, effective, instance, at)
+	}
+}
+
+// clamp はさかのぼって発火する期間より前の時刻を期間の始まりに切り上げる
+func (e *Engine) clamp(after time.Time) time.Time {
+	if floor := e.now().Add(-e.CatchUp); after.Before(floor) {
+		return floor
+	}
+	return after
+}
+
+// nextReminder はafterより後に発火するリマインダーのインスタンス、インスタンスでのリマインダー、発火時刻を返す（なければnil）
+// 絶対時刻のリマインダーとオーバーライドだけにあるリマインダーは1回だけ発火する
+// 繰り返しの親イベントのリマインダーはオーバーライドで変更・削除されていればその内容に従う
+func nextReminder(event *models.Event, overrides []*models.Event, owner *models.Event, reminder *models.Reminder, after time.Time) (*models.Event, *models.Reminder, time.Time, error) {
+	if reminder.IsAbsolute() || owner != event {
+		at := reminder.TriggerTime(owner)
+		if !at.After(after) {
+			return nil, nil, time.Time{}, nil
+		}
+		return owner, reminder, at, nil
+	}
+
+	// 発火時刻はインスタンスの開始からshiftだけずれるため、その分ずらした時刻より後のインスタンスから探す
+	shift := reminder.Offset
+	if reminder.Related == models.RelatedEnd {
+		shift += event.DTEnd.Sub(event.DTStart)
+	}
+	cursor := after.Add(-shift)
+	for i := 0; i < maxReminderScan; i++ {
+		instance, err := recurrence.Next(event, overrides, cursor)
+		if err != nil || instance == nil {
+			return nil, nil, time.Time{}, err
+		}
+		if effective := instance.FindReminder(reminder.ID); effective != nil {
+			if at := effective.TriggerTime(instance); at.After(after) {
+				return instance, effective, at, nil
+			}
+		}
+		cursor = instance.DTStart
+	}
+	return nil, nil, time.Time{}, nil
+}
+
+// fireDue は時刻を過ぎたインスタンスの開始とリマインダーを時刻順にすべて発火する
+func (e *Engine) fireDue(ctx context.Context) {
+	now := e.now()
+	for next := e.queue.peek(); next != nil && !next.at.After(now); next = e.queue.peek() {
+		e.fire(ctx, next, now)
+	}
+}
+
+// fire はインスタンスの開始またはリマインダーの発火を記録してアクションを実行し、イベントの次の発火を積み直す
+func (e *Engine) fire(ctx context.Context, next *entry, now time.Time) {
+	instance := next.instance
+	// オーバーライドで移動したインスタンスも元の開始時刻で識別する
+	occurrenceStart := instance.RecurrenceID
+	if occurrenceStart.IsZero() {
+		occurrenceStart = instance.DTStart
+	}
+
+	firing := &models.Firing{
+		ID:         recurrence.InstanceID(next.eventID, occurrenceStart),
+		EventID:    next.eventID,
+		CalendarID: instance.CalendarID,
+		Instance:   instance,
+		Reminder:   next.reminder,
+		Status:     models.FiringPending,
+		FiredAt:    now,
+	}
+	firedThrough := instance.DTStart
+	if next.reminder != nil {
+		// 絶対時刻のリマインダーは時刻ごとに1回だけ発火する
+		if next.reminder.IsAbsolute() {
+			firing.ID = recurrence.InstanceID(next.eventID, next.reminder.At)
+		}
+		firing.ID += "/" + next.reminder.ID
+		firedThrough = next.reminder.TriggerTime(instance)
+	}
+
+	inserted, err := e.storage.RecordFiring(firing, firedThrough)
+	if err != nil {
+		log.Printf("trigger: failed to record firing %s: %v", firing.ID, err)
+		e.queue.set(next.eventID, next.reminder, instance, now.Add(retryDelay))
+		return
+	}
+
+	// 記録済みの発火（オーバーライドで後ろに移動したインスタンスなど）はアクションを実行しない
+	if inserted {
+		e.runActions(ctx, firing)
+	}
+	e.reschedule(next.eventID)
+}
+
+// runActions はすべてのアクションを実行し、開始の処理を完了として記録する
+// アクションの失敗は記録するだけで再実行しない
+func (e *Engine) runActions(ctx context.Context, firing *models.Firing) {
+	for _, action := range e.actions {
+		if err := action.Fire(ctx, firing); err != nil {
+			log.Printf("trigger: action failed for firing %s: %v", firing.ID, err)
+		}
+	}
+	if err := e.storage.CompleteFiring(firing.ID); err != nil {
+		log.Printf("trigger: failed to complete firing %s: %v", firing.ID, err)
+	}
+}
@@ -0,0 +1,152 @@
+package trigger
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/recurrence-scheduler/internal/models"
+	"github.com/recurrence-scheduler/internal/storage"
+)
+
+// recordAction は発火したインスタンスの開始日時をUTCの「月-日T時:分」で記録する
+type recordAction struct {
+	fired []string
+}
+
+func (a *recordAction) Fire(_ context.Context, firing *models.Firing) error {
+	a.fired = append(a.fired, firing.Instance.DTStart.UTC().Format("01-02T15:04"))
+	return nil
+}
+
+// fakeClock はテストから進める時計
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time {
+	return c.t
+}
+
+// startEngine はサーバーの起動時と同じく、前回の続きを実行してから全イベントを読み込んだEngineを返す
+func startEngine(st storage.Storage, clock *fakeClock, action Action) *Engine {
+	e := NewEngine(st, action)
+	e.now = clock.now
+	e.recover(context.Background())
+	e.load()
+	return e
+}
+
+func newTestStorage(t *testing.T) (*storage.SQLiteStorage, *models.Calendar) {
+	t.Helper()
+	st, err := storage.NewSQLiteStorage(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { st.Close() })
+
+	cal := models.NewCalendar("test", "", "UTC")
+	if err := st.CreateCalendar(cal); err != nil {
+		t.Fatal(err)
+	}
+	return st, cal
+}
+
+func assertFired(t *testing.T, action *recordAction, want ...string) {
+	t.Helper()
+	if len(action.fired) != len(want) {
+		t.Fatalf("fired %v, want %v", action.fired, want)
+	}
+	for i := range want {
+		if action.fired[i] != want[i] {
+			t.Errorf("firing %d = %s, want %s", i, action.fired[i], want[i])
+		}
+	}
+	action.fired = nil
+}
+
+func TestEngineFiresEachOccurrenceOnceAcrossRestarts(t *testing.T) {
+	st, cal := newTestStorage(t)
+	start := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	event := models.NewEvent(cal.ID, "standup", "", start, start.Add(15*time.Minute), "FREQ=DAILY;COUNT=5", "UTC")
+	event.CreatedAt = start.Add(-time.Hour)
+	if err := st.CreateEvent(event); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	clock := &fakeClock{t: start.AddDate(0, 0, 1).Add(30 * time.Minute)}
+	action := &recordAction{}
+
+	// 1月6日のインスタンスはさかのぼる期間（24時間）より前に開始したため発火しない
+	e := startEngine(st, clock, action)
+	e.fireDue(ctx)
+	assertFired(t, action, "01-07T09:00")
+
+	// 同じ時刻に再起動しても、high-water markより後のインスタンスしか発火しない
+	e = startEngine(st, clock, action)
+	e.fireDue(ctx)
+	assertFired(t, action)
+
+	// 停止中に過ぎたインスタンスは再起動後に発火する
+	clock.t = start.AddDate(0, 0, 2).Add(10 * time.Hour)
+	e = startEngine(st, clock, action)
+	e.fireDue(ctx)
+	assertFired(t, action, "01-08T09:00")
+
+	clock.t = start.AddDate(0, 0, 3)
+	e.fireDue(ctx)
+	assertFired(t, action, "01-09T09:00")
+
+	// 発火済みのインスタンスを後ろに移動しても、元の開始時刻で識別するため二度は発火しない
+	override := models.NewEvent(cal.ID, "standup", "", start.AddDate(0, 0, 3).Add(6*time.Hour), start.AddDate(0, 0, 3).Add(6*time.Hour+15*time.Minute), "", "UTC")
+	override.RecurringEventID = event.ID
+	override.RecurrenceID = start.AddDate(0, 0, 3)
+	if err := st.CreateEvent(override); err != nil {
+		t.Fatal(err)
+	}
+	e.sync()
+	clock.t = start.AddDate(0, 0, 3).Add(7 * time.Hour)
+	e.fireDue(ctx)
+	assertFired(t, action)
+
+	clock.t = start.AddDate(0, 0, 10)
+	e.fireDue(ctx)
+	assertFired(t, action, "01-10T09:00")
+	e = startEngine(st, clock, action)
+	e.fireDue(ctx)
+	assertFired(t, action)
+}
+
+func TestEngineRecoversPendingFirings(t *testing.T) {
+	st, cal := newTestStorage(t)
+	start := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	event := models.NewEvent(cal.ID, "standup", "", start, start.Add(15*time.Minute), "FREQ=DAILY;COUNT=5", "UTC")
+	event.CreatedAt = start.Add(-time.Hour)
+	if err := st.CreateEvent(event); err != nil {
+		t.Fatal(err)
+	}
+
+	// 発火を記録した後、アクションの実行が終わる前に停止した
+	firing := &models.Firing{
+		ID:         event.ID + "/pending",
+		EventID:    event.ID,
+		CalendarID: cal.ID,
+		Instance:   event,
+		Status:     models.FiringPending,
+		FiredAt:    start,
+	}
+	if _, err := st.RecordFiring(firing, start); err != nil {
+		t.Fatal(err)
+	}
+
+	clock := &fakeClock{t: start.Add(time.Minute)}
+	action := &recordAction{}
+	startEngine(st, clock, action)
+	assertFired(t, action, "01-06T09:00")
+
+	// 実行を終えた発火は次の起動では実行しない
+	e := startEngine(st, clock, action)
+	e.fireDue(context.Background())
+	assertFired(t, action)
+}
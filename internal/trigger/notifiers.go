@@ -0,0 +1,188 @@
+package trigger
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/recurrence-scheduler/internal/models"
+	"github.com/recurrence-scheduler/internal/recurrence"
+)
+
+// StdoutNotifier はリマインダーをio.Writer（通常は標準出力）に1行ずつ書き出す
+// インスタンスの開始は書き出さない
+type StdoutNotifier struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewStdoutNotifier は新しいStdoutNotifierを作成する
+func NewStdoutNotifier(w io.Writer) *StdoutNotifier {
+	return &StdoutNotifier{w: w}
+}
+
+// Fire はActionの実装
+func (n *StdoutNotifier) Fire(ctx context.Context, firing *models.Firing) error {
+	if firing.Reminder == nil {
+		return nil
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	_, err := fmt.Fprintf(n.w, "reminder: %q starts at %s (event %s): %s\n", firing.Instance.Title,
+		formatStart(firing.Instance), firing.EventID, reminderText(firing))
+	return err
+}
+
+// smtpTimeout はリレーへの接続からメールを渡し終えるまでの上限
+// 応答しないリレーで発火のアクションの実行が止まらないようにする
+const smtpTimeout = 30 * time.Second
+
+// SMTPNotifier はEMAILのリマインダーを宛先にメールで送る
+// ローカルのリレー（MTA）に認証なしで渡すことを想定しており、送信と再送はリレーが行う
+type SMTPNotifier struct {
+	addr string
+	from string
+	send func(ctx context.Context, addr, from string, to []string, msg []byte) error
+}
+
+// NewSMTPNotifier はaddr（host:port）のリレーを使う新しいSMTPNotifierを作成する
+func NewSMTPNotifier(addr, from string) *SMTPNotifier {
+	return &SMTPNotifier{addr: addr, from: from, send: sendMail}
+}
+
+// Fire はActionの実装
+// 送信はsmtpTimeoutで打ち切り、ctxが終了した場合も中断する
+func (n *SMTPNotifier) Fire(ctx context.Context, firing *models.Firing) error {
+	reminder := firing.Reminder
+	if reminder == nil || reminder.Action != models.ReminderEmail {
+		return nil
+	}
+
+	// iCalendarから取り込んだ宛先は検証していないため、ヘッダーに書く前に解析し直す
+	var to []string
+	for _, recipient := range reminder.Recipients {
+		addr, err := mail.ParseAddress(recipient)
+		if err != nil {
+			log.Printf("trigger: skipped invalid recipient %q of reminder %s", recipient, reminder.ID)
+			continue
+		}
+		to = append(to, addr.Address)
+	}
+	if len(to) == 0 {
+		return nil
+	}
+
+	return n.send(ctx, n.addr, n.from, to, n.message(firing, to))
+}
+
+// sendMail はsmtp.SendMailの手順を写して、認証なしで送信する
+//
+// smtp.SendMailは内部で接続を開くため、接続にも送受信にも期限を指定できず、挨拶を返さないリレーでは無期限に待つ。
+// 期限を設定した接続をsmtp.NewClientに渡すには、SendMailが接続後に行う手順を自前で行う必要がある。
+//   - リレーがSTARTTLSに対応していれば、SendMailと同じくアドレスのホスト名で証明書を検証してTLSに切り替える
+//     （TLSへの切り替え後も同じ接続を使うため、期限はそのまま効く）
+//   - ローカルのリレーを想定しているため、SendMailのAUTHの手順は写していない
+//   - MAIL・RCPT・DATA・QUITの順に送る
+//
+// 期限はsmtpTimeoutとctxの期限の早い方で、ctxが終了した場合は待っている読み書きを中断する
+func sendMail(ctx context.Context, addr, from string, to []string, msg []byte) error {
+	deadline := time.Now().Add(smtpTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+
+	dialer := net.Dialer{Deadline: deadline}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+	// ctxが終了したら期限を過去にして、待っている読み書きを中断する
+	stop := context.AfterFunc(ctx, func() { conn.SetDeadline(time.Unix(1, 0)) })
+	defer stop()
+
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return err
+	}
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(from); err != nil {
+		return err
+	}
+	for _, recipient := range to {
+		if err := c.Rcpt(recipient); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// message はリマインダーのメールをRFC 5322の形式で組み立てる
+// Message-IDは発火のIDから決めるため、再起動後に送り直した場合も受信側で重複を判別できる
+func (n *SMTPNotifier) message(firing *models.Firing, to []string) []byte {
+	var b strings.Builder
+	header := func(name, value string) {
+		b.WriteString(name + ": " + value + "\r\n")
+	}
+	header("From", n.from)
+	header("To", strings.Join(to, ", "))
+	header("Subject", mime.QEncoding.Encode("utf-8", "Reminder: "+firing.Instance.Title))
+	header("Date", firing.FiredAt.Format(time.RFC1123Z))
+	header("Message-ID", "<"+uuid.NewSHA1(uuid.NameSpaceURL, []byte(firing.ID)).String()+"@recurrence-scheduler>")
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=utf-8")
+	header("Content-Transfer-Encoding", "8bit")
+	b.WriteString("\r\n")
+
+	body := reminderText(firing) + "\n\n" + "Starts: " + formatStart(firing.Instance) + "\n"
+	b.WriteString(strings.ReplaceAll(strings.ReplaceAll(body, "\r\n", "\n"), "\n", "\r\n"))
+	return []byte(b.String())
+}
+
+// reminderText はリマインダーの説明を返す（なければイベントのタイトル）
+func reminderText(firing *models.Firing) string {
+	if firing.Reminder.Description != "" {
+		return firing.Reminder.Description
+	}
+	return firing.Instance.Title
+}
+
+// formatStart はインスタンスの開始時刻をイベントのタイムゾーンで表す
+func formatStart(instance *models.Event) string {
+	return instance.DTStart.In(recurrence.Location(instance.Timezone)).Format(time.RFC3339)
+}
@@ -0,0 +1,43 @@
+package trigger
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestSendMailGivesUpOnUnresponsiveRelay(t *testing.T) {
+	// 接続を受け付けるだけで挨拶を返さないリレー
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- sendMail(ctx, ln.Addr().String(), "from@example.com", []string{"to@example.com"}, []byte("Subject: test\r\n\r\nbody\r\n"))
+	}()
+
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Error("sendMail succeeded against a relay that never answered")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("sendMail did not give up")
+	}
+}
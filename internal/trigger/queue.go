@@ -0,0 +1,103 @@
+package trigger
+
+import (
+	"container/heap"
+	"time"
+
+	"github.com/recurrence-scheduler/internal/models"
+)
+
+// entry はイベントの次に開始するインスタンス、またはリマインダーの次の発火
+type entry struct {
+	key      string
+	eventID  string
+	instance *models.Event
+	reminder *models.Reminder // リマインダーの発火の場合のみ設定する
+	at       time.Time
+	index    int
+}
+
+// entryKey はキューの中でエントリを識別するキー
+// インスタンスの開始はイベントごと、リマインダーはイベントとリマインダーの組ごとに1件だけ持つ
+func entryKey(eventID string, reminder *models.Reminder) string {
+	if reminder == nil {
+		return eventID
+	}
+	return eventID + "/" + reminder.ID
+}
+
+// timerQueue はキーごとに次の発火を1件だけ持ち、発火時刻の早い順に取り出すヒープ
+type timerQueue struct {
+	entries []*entry
+	byKey   map[string]*entry
+}
+
+func newTimerQueue() *timerQueue {
+	return &timerQueue{byKey: make(map[string]*entry)}
+}
+
+// set はイベント（reminderがnilでなければそのリマインダー）の次の発火を置き換える（instanceがnilの場合は取り除く）
+func (q *timerQueue) set(eventID string, reminder *models.Reminder, instance *models.Event, at time.Time) {
+	key := entryKey(eventID, reminder)
+	if e, ok := q.byKey[key]; ok {
+		if instance == nil {
+			q.remove(e)
+			return
+		}
+		e.instance, e.reminder, e.at = instance, reminder, at
+		heap.Fix(q, e.index)
+		return
+	}
+	if instance != nil {
+		e := &entry{key: key, eventID: eventID, instance: instance, reminder: reminder, at: at}
+		heap.Push(q, e)
+		q.byKey[key] = e
+	}
+}
+
+// removeEvent はイベントの開始とすべてのリマインダーの発火を取り除く
+func (q *timerQueue) removeEvent(eventID string) {
+	for _, e := range q.byKey {
+		if e.eventID == eventID {
+			q.remove(e)
+		}
+	}
+}
+
+func (q *timerQueue) remove(e *entry) {
+	heap.Remove(q, e.index)
+	delete(q.byKey, e.key)
+}
+
+// peek は最も早く発火するエントリを返す（空の場合はnil）
+func (q *timerQueue) peek() *entry {
+	if len(q.entries) == 0 {
+		return nil
+	}
+	return q.entries[0]
+}
+
+// heap.Interfaceの実装
+func (q *timerQueue) Len() int { return len(q.entries) }
+
+func (q *timerQueue) Less(i, j int) bool { return q.entries[i].at.Before(q.entries[j].at) }
+
+func (q *timerQueue) Swap(i, j int) {
+	q.entries[i], q.entries[j] = q.entries[j], q.entries[i]
+	q.entries[i].index = i
+	q.entries[j].index = j
+}
+
+func (q *timerQueue) Push(x any) {
+	e := x.(*entry)
+	e.index = len(q.entries)
+	q.entries = append(q.entries, e)
+}
+
+func (q *timerQueue) Pop() any {
+	n := len(q.entries)
+	e := q.entries[n-1]
+	q.entries[n-1] = nil
+	q.entries = q.entries[:n-1]
+	return e
+}
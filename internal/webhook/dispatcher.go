@@ -0,0 +1,181 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/recurrence-scheduler/internal/models"
+	"github.com/recurrence-scheduler/internal/storage"
+)
+
+const (
+	// MaxAttempts は配信をデッドレターにするまでの送信回数
+	MaxAttempts = 10
+
+	// 再送の間隔は30秒から倍々に伸ばし、6時間で頭打ちにする
+	initialBackoff = 30 * time.Second
+	maxBackoff     = 6 * time.Hour
+
+	// requestTimeout は1回の送信で応答を待つ時間
+	requestTimeout = 10 * time.Second
+	// batchSize は1回のポーリングで送信する配信の上限
+	batchSize = 100
+	// maxErrorBody は失敗時に配信ログへ残す応答本文の上限
+	maxErrorBody = 512
+)
+
+// Backoff はattempts回失敗した配信を次に送るまでの待ち時間を返す
+func Backoff(attempts int) time.Duration {
+	d := initialBackoff
+	for i := 1; i < attempts; i++ {
+		d *= 2
+		if d >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return d
+}
+
+// Dispatcher はストレージに登録された配信を送信し、失敗した配信を指数バックオフで再送する
+// 配信はSQLiteに保存されているため、サーバーを再起動しても未送信の配信は失われない
+type Dispatcher struct {
+	storage storage.Storage
+	client  *http.Client
+
+	// PollInterval は送信時刻を過ぎた配信を探す間隔
+	PollInterval time.Duration
+	// Concurrency は同時に送信する配信の数
+	Concurrency int
+}
+
+// NewDispatcher は新しいDispatcherを作成する
+func NewDispatcher(st storage.Storage) *Dispatcher {
+	return &Dispatcher{
+		storage: st,
+		client: &http.Client{
+			Timeout: requestTimeout,
+			// リダイレクト先には署名済みの本文を送らず、失敗として再送する
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		PollInterval: 2 * time.Second,
+		Concurrency:  4,
+	}
+}
+
+// Run はctxが終了するまで配信を送り続ける
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		d.dispatchDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// dispatchDue は送信時刻を過ぎた配信を最大batchSize件送信し、すべての結果を記録するまで待つ
+func (d *Dispatcher) dispatchDue(ctx context.Context) {
+	deliveries, err := d.storage.ListDueDeliveries(time.Now(), batchSize)
+	if err != nil {
+		log.Printf("webhook: failed to list deliveries: %v", err)
+		return
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, max(d.Concurrency, 1))
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(delivery *models.WebhookDelivery) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			d.deliver(ctx, delivery)
+		}(delivery)
+	}
+	wg.Wait()
+}
+
+// deliver は配信を1回送信し、結果を配信ログに記録する
+func (d *Dispatcher) deliver(ctx context.Context, delivery *models.WebhookDelivery) {
+	webhook, err := d.storage.GetWebhook(delivery.WebhookID)
+	if err != nil {
+		// Webhookが削除された場合は配信もまとめて削除されている
+		return
+	}
+
+	start := time.Now()
+	statusCode, err := d.send(ctx, webhook, delivery, start)
+	if ctx.Err() != nil {
+		// サーバーの停止で中断した送信は失敗として数えない
+		return
+	}
+
+	attempt := &models.WebhookAttempt{
+		DeliveryID:  delivery.ID,
+		AttemptedAt: start,
+		StatusCode:  statusCode,
+		Duration:    time.Since(start),
+	}
+
+	delivery.Attempts++
+	if err == nil {
+		delivery.Status = models.DeliveryDelivered
+		delivery.LastError = ""
+	} else {
+		attempt.Error = err.Error()
+		delivery.LastError = err.Error()
+		if delivery.Attempts >= MaxAttempts {
+			delivery.Status = models.DeliveryDead
+		} else {
+			delivery.NextAttemptAt = start.Add(Backoff(delivery.Attempts))
+		}
+	}
+
+	if err := d.storage.RecordAttempt(delivery, attempt); err != nil {
+		log.Printf("webhook: failed to record attempt for delivery %s: %v", delivery.ID, err)
+	}
+}
+
+// send は署名したペイロードをPOSTし、応答のステータスコードを返す
+// 2xx以外の応答はエラーとして扱う
+func (d *Dispatcher) send(ctx context.Context, webhook *models.Webhook, delivery *models.WebhookDelivery, now time.Time) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "recurrence-scheduler-webhook/1.0")
+	req.Header.Set(EventHeader, delivery.Type.EventName())
+	req.Header.Set(DeliveryHeader, delivery.ID)
+	req.Header.Set(SignatureHeader, Sign(webhook.Secret, now, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		io.Copy(io.Discard, io.LimitReader(resp.Body, maxErrorBody))
+		return resp.StatusCode, nil
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	if len(body) > 0 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+	}
+	return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+}
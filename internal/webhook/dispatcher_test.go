@@ -0,0 +1,195 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/recurrence-scheduler/internal/models"
+	"github.com/recurrence-scheduler/internal/storage"
+)
+
+// newTestDelivery はhandlerに送信するWebhookを登録し、イベントを作成して配信を1件登録する
+func newTestDelivery(t *testing.T, handler http.HandlerFunc) (*Dispatcher, *storage.SQLiteStorage, *models.WebhookDelivery) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	st, err := storage.NewSQLiteStorage(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { st.Close() })
+
+	cal := models.NewCalendar("test", "", "UTC")
+	if err := st.CreateCalendar(cal); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.CreateWebhook(models.NewWebhook(cal.ID, server.URL, "secret", nil)); err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	if err := st.CreateEvent(models.NewEvent(cal.ID, "event", "", start, start.Add(time.Hour), "", "UTC")); err != nil {
+		t.Fatal(err)
+	}
+
+	deliveries, err := st.ListDueDeliveries(time.Now().Add(time.Second), batchSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries) != 1 {
+		t.Fatalf("got %d deliveries, want 1", len(deliveries))
+	}
+	return NewDispatcher(st), st, deliveries[0]
+}
+
+// storedDelivery は配信の保存されている状態を読み出す
+func storedDelivery(t *testing.T, st *storage.SQLiteStorage, delivery *models.WebhookDelivery) *models.WebhookDelivery {
+	t.Helper()
+	deliveries, err := st.ListDeliveries(delivery.WebhookID, "", storage.Cursor{}, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range deliveries {
+		if d.ID == delivery.ID {
+			return d
+		}
+	}
+	t.Fatalf("delivery %s not found", delivery.ID)
+	return nil
+}
+
+func TestDeliverSuccess(t *testing.T) {
+	var got *http.Request
+	var body []byte
+	d, st, delivery := newTestDelivery(t, func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	d.deliver(context.Background(), delivery)
+
+	if got == nil {
+		t.Fatal("webhook was not called")
+	}
+	if err := Verify("secret", got.Header.Get(SignatureHeader), body, time.Now(), time.Minute); err != nil {
+		t.Errorf("signature: %v", err)
+	}
+	if h := got.Header.Get(EventHeader); h != "event.created" {
+		t.Errorf("%s = %q, want event.created", EventHeader, h)
+	}
+	if h := got.Header.Get(DeliveryHeader); h != delivery.ID {
+		t.Errorf("%s = %q, want %q", DeliveryHeader, h, delivery.ID)
+	}
+
+	stored := storedDelivery(t, st, delivery)
+	if stored.Status != models.DeliveryDelivered || stored.Attempts != 1 {
+		t.Errorf("status, attempts = %s, %d; want delivered, 1", stored.Status, stored.Attempts)
+	}
+	attempts, err := st.ListAttempts(delivery.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(attempts) != 1 || attempts[0].StatusCode != http.StatusNoContent || attempts[0].Error != "" {
+		t.Errorf("attempts = %+v, want one successful attempt with status 204", attempts)
+	}
+}
+
+func TestDeliverFailureReschedulesWithBackoff(t *testing.T) {
+	d, st, delivery := newTestDelivery(t, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "boom", http.StatusInternalServerError)
+	})
+
+	before := time.Now()
+	d.deliver(context.Background(), delivery)
+
+	stored := storedDelivery(t, st, delivery)
+	if stored.Status != models.DeliveryPending || stored.Attempts != 1 {
+		t.Errorf("status, attempts = %s, %d; want pending, 1", stored.Status, stored.Attempts)
+	}
+	if !strings.Contains(stored.LastError, "500") || !strings.Contains(stored.LastError, "boom") {
+		t.Errorf("last error = %q, want the status and body", stored.LastError)
+	}
+	// 保存される日時は秒単位
+	if wait := stored.NextAttemptAt.Sub(before); wait < Backoff(1)-time.Second || wait > Backoff(1)+time.Second {
+		t.Errorf("next attempt in %v, want %v", wait, Backoff(1))
+	}
+
+	due, err := st.ListDueDeliveries(time.Now(), batchSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(due) != 0 {
+		t.Errorf("delivery is due again before its backoff")
+	}
+	due, err = st.ListDueDeliveries(before.Add(Backoff(1)+time.Second), batchSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(due) != 1 {
+		t.Errorf("delivery is not due after its backoff")
+	}
+
+	attempts, err := st.ListAttempts(delivery.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(attempts) != 1 || attempts[0].StatusCode != http.StatusInternalServerError {
+		t.Errorf("attempts = %+v, want one attempt with status 500", attempts)
+	}
+}
+
+func TestDeliverDeadLettersAtMaxAttempts(t *testing.T) {
+	d, st, delivery := newTestDelivery(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	delivery.Attempts = MaxAttempts - 1
+	d.deliver(context.Background(), delivery)
+
+	stored := storedDelivery(t, st, delivery)
+	if stored.Status != models.DeliveryDead || stored.Attempts != MaxAttempts {
+		t.Errorf("status, attempts = %s, %d; want dead, %d", stored.Status, stored.Attempts, MaxAttempts)
+	}
+
+	dead, err := st.ListDeadLetters(delivery.CalendarID, storage.Cursor{}, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dead) != 1 || dead[0].ID != delivery.ID {
+		t.Errorf("dead letters = %d, want the delivery", len(dead))
+	}
+	due, err := st.ListDueDeliveries(time.Now().Add(maxBackoff+time.Hour), batchSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(due) != 0 {
+		t.Errorf("dead delivery is still scheduled")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{3, 2 * time.Minute},
+		{9, 128 * time.Minute},
+		{10, 256 * time.Minute},
+		{11, maxBackoff},
+		{50, maxBackoff},
+	}
+	for _, tt := range tests {
+		if got := Backoff(tt.attempts); got != tt.want {
+			t.Errorf("Backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
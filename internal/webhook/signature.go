@@ -0,0 +1,66 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 配信のリクエストに付けるヘッダー
+const (
+	// SignatureHeader は "t=<UNIX時刻>,v1=<署名>" 形式の署名
+	SignatureHeader = "X-Scheduler-Signature"
+	// EventHeader は "event.created" などの変更の種別
+	EventHeader = "X-Scheduler-Event"
+	// DeliveryHeader は配信のID。再送でも変わらないため、受信側で重複の排除に使える
+	DeliveryHeader = "X-Scheduler-Delivery"
+)
+
+// Sign はWebhookの鍵でペイロードに署名し、SignatureHeaderの値を返す
+// 署名は "<UNIX時刻>.<本文>" のHMAC-SHA256で、時刻を含めることで古いリクエストの再送を検出できる
+func Sign(secret string, t time.Time, body []byte) string {
+	ts := strconv.FormatInt(t.Unix(), 10)
+	return "t=" + ts + ",v1=" + signature(secret, ts, body)
+}
+
+// Verify はSignatureHeaderの値が本文の正しい署名で、時刻がnowからtolerance以内かを検証する
+func Verify(secret, header string, body []byte, now time.Time, tolerance time.Duration) error {
+	var ts string
+	var sigs []string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "t":
+			ts = value
+		case "v1":
+			sigs = append(sigs, value)
+		}
+	}
+
+	sec, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil || len(sigs) == 0 {
+		return errors.New("malformed signature header")
+	}
+	if d := now.Sub(time.Unix(sec, 0)); d > tolerance || d < -tolerance {
+		return errors.New("signature timestamp is outside the tolerance")
+	}
+
+	expected := signature(secret, ts, body)
+	for _, sig := range sigs {
+		if hmac.Equal([]byte(sig), []byte(expected)) {
+			return nil
+		}
+	}
+	return errors.New("signature mismatch")
+}
+
+func signature(secret, ts string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts + "."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
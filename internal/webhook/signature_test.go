@@ -0,0 +1,43 @@
+package webhook
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSignVerify(t *testing.T) {
+	now := time.Unix(1736150400, 0)
+	body := []byte(`{"type":"event.created"}`)
+	header := Sign("secret", now, body)
+	ts := strconv.FormatInt(now.Unix(), 10)
+
+	tests := []struct {
+		name    string
+		secret  string
+		header  string
+		body    []byte
+		now     time.Time
+		wantErr bool
+	}{
+		{name: "round trip", secret: "secret", header: header, body: body, now: now},
+		{name: "within tolerance", secret: "secret", header: header, body: body, now: now.Add(5 * time.Minute)},
+		{name: "too old", secret: "secret", header: header, body: body, now: now.Add(5*time.Minute + time.Second), wantErr: true},
+		{name: "too far in the future", secret: "secret", header: header, body: body, now: now.Add(-5*time.Minute - time.Second), wantErr: true},
+		{name: "wrong secret", secret: "other", header: header, body: body, now: now, wantErr: true},
+		{name: "tampered body", secret: "secret", header: header, body: []byte(`{"type":"event.deleted"}`), now: now, wantErr: true},
+		{name: "one of several signatures matches", secret: "secret", header: "t=" + ts + ",v1=deadbeef," + header[len("t="+ts+","):], body: body, now: now},
+		{name: "missing timestamp", secret: "secret", header: header[len("t="+ts+","):], body: body, now: now, wantErr: true},
+		{name: "missing signature", secret: "secret", header: "t=" + ts, body: body, now: now, wantErr: true},
+		{name: "empty header", secret: "secret", header: "", body: body, now: now, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Verify(tt.secret, tt.header, tt.body, tt.now, 5*time.Minute)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -14,7 +14,8 @@ export function eventCardComponent(event: Event) {
     },
 
     get recurrenceText() {
-      return this.event.rrule ? formatRRule(this.event.rrule) : '';
+      if (!this.event.rrule) return '';
+      return this.event.rrule_text || formatRRule(this.event.rrule);
     },
 
     get hasRecurrence() {
@@ -1,7 +1,10 @@
 import type {
   Calendar,
+  Conflict,
   CreateCalendarRequest,
   CreateEventRequest,
+  DescribeRuleRequest,
+  DescribeRuleResponse,
   Event,
   ListCalendarsResponse,
   ListEventsResponse,
@@ -76,8 +79,8 @@ export const api = {
     return apiCall<{ event: Event }>(`/events/${id}`);
   },
 
-  async createEvent(request: CreateEventRequest): Promise<{ event: Event }> {
-    return apiCall<{ event: Event }>('/events', 'POST', request);
+  async createEvent(request: CreateEventRequest): Promise<{ event: Event; conflicts?: Conflict[] }> {
+    return apiCall<{ event: Event; conflicts?: Conflict[] }>('/events', 'POST', request);
   },
 
   async expandRecurrence(
@@ -91,6 +94,10 @@ export const api = {
       { start, end }
     );
   },
+
+  async describeRule(request: DescribeRuleRequest): Promise<DescribeRuleResponse> {
+    return apiCall<DescribeRuleResponse>('/rules:describe', 'POST', request);
+  },
 };
 
 export { ApiError };
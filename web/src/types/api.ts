@@ -10,16 +10,40 @@ export interface RecurrenceRule {
   bymonth?: number[];
   byweekno?: number[];
   wkst?: string;
+  bysetpos?: number[];
+  byyearday?: number[];
+  byhour?: number[];
+  byminute?: number[];
+  bysecond?: number[];
+}
+
+export type ReminderAction = 'REMINDER_ACTION_UNSPECIFIED' | 'REMINDER_ACTION_DISPLAY' | 'REMINDER_ACTION_EMAIL';
+
+export type ReminderRelated = 'REMINDER_RELATED_UNSPECIFIED' | 'REMINDER_RELATED_START' | 'REMINDER_RELATED_END';
+
+// リマインダー（VALARM）。atを指定すると絶対時刻、そうでなければoffset（RFC 5545のDURATION）で指定する
+export interface Reminder {
+  id?: string;
+  action?: ReminderAction;
+  offset?: string;
+  related?: ReminderRelated;
+  at?: string;
+  description?: string;
+  recipients?: string[];
 }
 
 export interface Event {
   id: string;
+  uid?: string;
   title: string;
   description?: string;
   dtstart: string;
   dtend: string;
   rrule?: RecurrenceRule;
   timezone: string;
+  reminders?: Reminder[];
+  rrule_text?: string;
+  all_day?: boolean;
   created_at: string;
   updated_at: string;
 }
@@ -48,14 +72,179 @@ export interface CreateEventRequest {
   dtend: string;
   rrule?: RecurrenceRule;
   timezone?: string;
+  conflict_policy?: ConflictPolicy;
+  conflict_calendar_ids?: string[];
+  conflict_horizon_days?: number;
+  reminders?: Reminder[];
+  cron?: string;
+}
+
+export interface DescribeRuleRequest {
+  rrule: RecurrenceRule;
+  locale?: string;
+  dtstart?: string;
+  timezone?: string;
+}
+
+export interface DescribeRuleResponse {
+  text: string;
 }
 
 export interface ListCalendarsResponse {
   calendars: Calendar[];
   next_page_token?: string;
+  total_size: number;
 }
 
 export interface ListEventsResponse {
   events: Event[];
   next_page_token?: string;
+  total_size: number;
+}
+
+export interface ImportResult {
+  uid: string;
+  recurrence_id?: string;
+  status: 'CREATED' | 'UPDATED' | 'REJECTED';
+  event_id?: string;
+  message?: string;
+}
+
+export interface ImportCalendarResponse {
+  results: ImportResult[];
+  created: number;
+  updated: number;
+  rejected: number;
+}
+
+export interface RotateFeedTokenResponse {
+  feed_token: string;
+  feed_path: string;
+}
+
+export interface TimeInterval {
+  start: string;
+  end: string;
+}
+
+export interface CalendarFreeBusy {
+  calendar_id: string;
+  busy: TimeInterval[];
+}
+
+export interface QueryFreeBusyRequest {
+  calendar_ids: string[];
+  start: string;
+  end: string;
+}
+
+export interface QueryFreeBusyResponse {
+  calendars: CalendarFreeBusy[];
+  ical: string;
+}
+
+export interface WorkingHours {
+  start: string;
+  end: string;
+  days?: string[];
+}
+
+export interface FindAvailableSlotsRequest {
+  calendar_ids: string[];
+  start: string;
+  end: string;
+  duration_minutes: number;
+  working_hours?: WorkingHours;
+  buffer_minutes?: number;
+  max_results?: number;
+  timezone?: string;
+}
+
+export interface FindAvailableSlotsResponse {
+  slots: TimeInterval[];
+}
+
+export type ConflictPolicy =
+  | 'CONFLICT_POLICY_UNSPECIFIED'
+  | 'CONFLICT_POLICY_IGNORE'
+  | 'CONFLICT_POLICY_WARN'
+  | 'CONFLICT_POLICY_REJECT';
+
+export interface Conflict {
+  event_id: string;
+  recurring_event_id?: string;
+  calendar_id: string;
+  start: string;
+  end: string;
+  occurrence_start: string;
+}
+
+export type ChangeType =
+  | 'CHANGE_TYPE_UNSPECIFIED'
+  | 'CHANGE_TYPE_CREATED'
+  | 'CHANGE_TYPE_UPDATED'
+  | 'CHANGE_TYPE_DELETED'
+  | 'CHANGE_TYPE_OCCURRENCE_STARTED' // Webhookのevent_typesでのみ使う
+  | 'CHANGE_TYPE_REMINDER_DUE'; // Webhookのevent_typesでのみ使う
+
+export interface EventChange {
+  change_type: ChangeType;
+  calendar_id: string;
+  event_id: string;
+  uid: string;
+  event?: Event;
+  changed_at: string;
+  resume_token: string;
+}
+
+export interface Webhook {
+  id: string;
+  calendar_id: string;
+  url: string;
+  event_types: ChangeType[];
+  secret?: string; // CreateWebhookの応答でのみ返される
+  created_at: string;
+}
+
+export interface CreateWebhookRequest {
+  calendar_id: string;
+  url: string;
+  event_types?: ChangeType[];
+}
+
+export type DeliveryStatus =
+  | 'DELIVERY_STATUS_UNSPECIFIED'
+  | 'DELIVERY_STATUS_PENDING'
+  | 'DELIVERY_STATUS_DELIVERED'
+  | 'DELIVERY_STATUS_DEAD';
+
+export interface WebhookAttempt {
+  attempted_at: string;
+  status_code: number;
+  error?: string;
+  duration_ms: number;
+}
+
+export interface WebhookDelivery {
+  id: string;
+  webhook_id: string;
+  calendar_id: string;
+  event_id: string;
+  change_type: ChangeType;
+  payload: string;
+  status: DeliveryStatus;
+  attempts: number;
+  next_attempt_at?: string;
+  last_error?: string;
+  created_at: string;
+  updated_at: string;
+  attempt_log: WebhookAttempt[];
+}
+
+export interface OccurrenceStarted {
+  firing_id: string;
+  event_id: string;
+  calendar_id: string;
+  instance: Event;
+  fired_at: string;
 }